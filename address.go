@@ -6,9 +6,12 @@ package koios
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/shopspring/decimal"
 )
@@ -45,37 +48,19 @@ type (
 	}
 
 	// AddressInfoResponse represents response from `/address_info` endpoint.
-	AddressInfoResponse struct {
-		Response
-		Data *AddressInfo `json:"data"`
-	}
-	AddressesInfoResponse struct {
-		Response
-		Data []AddressInfo `json:"data"`
-	}
+	AddressInfoResponse   = Response[*AddressInfo]
+	AddressesInfoResponse = ListResponse[AddressInfo]
 
 	// AddressTxsResponse represents response from `/address_txs` endpoint.
-	AddressTxsResponse struct {
-		Response
-		Data []AddressTx `json:"data"`
-	}
+	AddressTxsResponse = ListResponse[AddressTx]
 
 	// CredentialTxsResponse represents response from `/credential_txs` endpoint.
-	CredentialTxsResponse struct {
-		Response
-		Data []AddressTx `json:"data"`
-	}
+	CredentialTxsResponse = ListResponse[AddressTx]
 
 	// AddressAssetsResponse represents response from `/address_info` endpoint.
-	AddressAssetsResponse struct {
-		Response
-		Data *AddressAssets `json:"data"`
-	}
+	AddressAssetsResponse = Response[*AddressAssets]
 
-	AddressesAssetsResponse struct {
-		Response
-		Data []AddressAssets `json:"data"`
-	}
+	AddressesAssetsResponse = ListResponse[AddressAssets]
 
 	AddressAsset struct {
 		AssetName      AssetName       `json:"asset_name"`
@@ -83,21 +68,243 @@ type (
 		Balance        decimal.Decimal `json:"balance"`
 	}
 
-	UTxOsResponse struct {
-		Response
-		Data []UTxO `json:"data"`
+	UTxOsResponse = ListResponse[UTxO]
+
+	// NetworkID is network id encoded in Shelley address header.
+	NetworkID uint8
+
+	// AddressKind is kind of address determined by address header.
+	AddressKind uint8
+
+	// AddressPointer points to stake key registration certificate on chain.
+	AddressPointer struct {
+		Slot      Slot
+		TxIndex   uint64
+		CertIndex uint64
+	}
+
+	// Credential is key hash or script hash used to construct addresses.
+	Credential struct {
+		// Hash is 28 byte blake2b-224 hash of verification key or script.
+		Hash []byte
+		// Script is true when Hash is script hash.
+		Script bool
+	}
+
+	// DecodedAddress holds details of decoded Shelley or Byron address.
+	DecodedAddress struct {
+		Kind AddressKind
+		// Header byte of Shelley address, 0x80 for Byron addresses.
+		Header byte
+		// NetworkID of the address. Byron addresses are reported as
+		// NetworkIDMainnet when they have no protocol magic attribute.
+		NetworkID NetworkID
+		// ProtocolMagic attribute of Byron testnet addresses.
+		ProtocolMagic *uint32
+		// HRP human readable part of bech32 address, empty for Byron addresses.
+		HRP string
+		// Payment credential, empty for reward and Byron addresses.
+		Payment       PaymentCredential
+		PaymentScript bool
+		// Stake credential of base and reward addresses.
+		Stake       StakeCredential
+		StakeScript bool
+		// Pointer of pointer addresses.
+		Pointer *AddressPointer
+		// Raw address bytes.
+		Raw []byte
 	}
 )
 
+const (
+	NetworkIDTestnet NetworkID = 0
+	NetworkIDMainnet NetworkID = 1
+)
+
+const (
+	AddressKindUnknown AddressKind = iota
+	AddressKindBase
+	AddressKindPointer
+	AddressKindEnterprise
+	AddressKindReward
+	AddressKindByron
+)
+
+const (
+	hrpAddr      = "addr"
+	hrpAddrTest  = "addr_test"
+	hrpStake     = "stake"
+	hrpStakeTest = "stake_test"
+	hrpPool      = "pool"
+
+	// credentialLen is length of key hash or script hash in address.
+	credentialLen = 28
+)
+
+// KeyHashCredential returns credential of 28 byte verification key hash.
+func KeyHashCredential(hash []byte) (Credential, error) {
+	if len(hash) != credentialLen {
+		return Credential{}, fmt.Errorf("%w: key hash must be %d bytes got %d", ErrCredential, credentialLen, len(hash))
+	}
+	return Credential{Hash: append([]byte(nil), hash...)}, nil
+}
+
+// ScriptHashCredential returns credential of native or Plutus script hash.
+func ScriptHashCredential(hash ScriptHash) (Credential, error) {
+	b, err := hex.DecodeString(hash.String())
+	if err != nil {
+		return Credential{}, fmt.Errorf("%w: %s", ErrCredential, err.Error())
+	}
+	if len(b) != credentialLen {
+		return Credential{}, fmt.Errorf("%w: script hash must be %d bytes got %d", ErrCredential, credentialLen, len(b))
+	}
+	return Credential{Hash: b, Script: true}, nil
+}
+
+// VKeyCredential returns credential of verification key from
+// cardano-cli text envelope (.vkey file contents) e.g.
+//
+//	{
+//	  "type": "PaymentVerificationKeyShelley_ed25519",
+//	  "description": "Payment Verification Key",
+//	  "cborHex": "5820..."
+//	}
+//
+// Extended verification keys are supported, chain code is ignored.
+func VKeyCredential(envelope []byte) (Credential, error) {
+	var te struct {
+		Type    string `json:"type"`
+		CborHex string `json:"cborHex"`
+	}
+	if err := json.Unmarshal(envelope, &te); err != nil {
+		return Credential{}, fmt.Errorf("%w: %s", ErrCredential, err.Error())
+	}
+	if !strings.Contains(te.Type, "VerificationKey") {
+		return Credential{}, fmt.Errorf("%w: unsupported text envelope type %q", ErrCredential, te.Type)
+	}
+	raw, err := hex.DecodeString(te.CborHex)
+	if err != nil {
+		return Credential{}, fmt.Errorf("%w: %s", ErrCredential, err.Error())
+	}
+	r := newCBORReader(raw)
+	key, err := r.bytes()
+	if err != nil || !r.done() {
+		return Credential{}, fmt.Errorf("%w: cborHex must be cbor encoded key bytes", ErrCredential)
+	}
+	switch len(key) {
+	case 32:
+	case 64:
+		// extended key, drop chain code.
+		key = key[:32]
+	default:
+		return Credential{}, fmt.Errorf("%w: invalid verification key length %d", ErrCredential, len(key))
+	}
+	return Credential{Hash: blake2bSum(key, credentialLen)}, nil
+}
+
+// Hex returns hex encoded hash of the credential.
+func (c Credential) Hex() string {
+	return hex.EncodeToString(c.Hash)
+}
+
+// PaymentCredential returns hex encoded credential as PaymentCredential.
+func (c Credential) PaymentCredential() PaymentCredential {
+	return PaymentCredential(c.Hex())
+}
+
+// StakeCredential returns hex encoded credential as StakeCredential.
+func (c Credential) StakeCredential() StakeCredential {
+	return StakeCredential(c.Hex())
+}
+
+// NewEnterpriseAddress returns enterprise address (without stake credential)
+// of payment key or script credential.
+func NewEnterpriseAddress(net NetworkID, payment Credential) (Address, error) {
+	var typ byte = 6
+	if payment.Script {
+		typ = 7
+	}
+	return encodeAddress(typ, net, payment.Hash)
+}
+
+// NewBaseAddress returns base address of payment and stake credentials.
+func NewBaseAddress(net NetworkID, payment, stake Credential) (Address, error) {
+	var typ byte
+	if payment.Script {
+		typ |= 1
+	}
+	if stake.Script {
+		typ |= 2
+	}
+	return encodeAddress(typ, net, payment.Hash, stake.Hash)
+}
+
+// NewRewardAddress returns reward (stake) address of stake key or script credential.
+func NewRewardAddress(net NetworkID, stake Credential) (Address, error) {
+	return encodeAddress(rewardType(stake.Script), net, stake.Hash)
+}
+
+// String returns name of the address kind.
+func (k AddressKind) String() string {
+	switch k {
+	case AddressKindBase:
+		return "base"
+	case AddressKindPointer:
+		return "pointer"
+	case AddressKindEnterprise:
+		return "enterprise"
+	case AddressKindReward:
+		return "reward"
+	case AddressKindByron:
+		return "byron"
+	}
+	return "unknown"
+}
+
 // Valid validates address and returns false and error
 // if address is invalid otherwise it returns true, nil.
 func (a Address) Valid() (bool, error) {
-	if len(a) == 0 {
-		return false, ErrNoAddress
+	if _, err := a.Decode(); err != nil {
+		return false, err
 	}
 	return true, nil
 }
 
+// Decode decodes bech32 Shelley address or base58 Byron address.
+// Bech32 human readable part is checked against network id and
+// kind of the address.
+func (a Address) Decode() (*DecodedAddress, error) {
+	if len(a) == 0 {
+		return nil, ErrNoAddress
+	}
+	s := string(a)
+	lower := strings.ToLower(s)
+	if !strings.HasPrefix(lower, hrpAddr) && !strings.HasPrefix(lower, hrpStake) {
+		return decodeByron(s)
+	}
+	hrp, raw, err := bech32Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAddress, err.Error())
+	}
+	return decodeShelley(hrp, raw)
+}
+
+// StakeAddress returns reward address of base address stake credential.
+// Reward address returns itself.
+func (a Address) StakeAddress() (Address, error) {
+	d, err := a.Decode()
+	if err != nil {
+		return "", err
+	}
+	switch d.Kind {
+	case AddressKindReward:
+		return Address(strings.ToLower(a.String())), nil
+	case AddressKindBase:
+		return encodeAddress(rewardType(d.StakeScript), d.NetworkID, d.Raw[1+credentialLen:])
+	}
+	return "", fmt.Errorf("%w: %s address", ErrNoStakeCredential, d.Kind)
+}
+
 // String returns StakeAddress as string.
 func (a Address) String() string {
 	return string(a)
@@ -117,12 +324,11 @@ func (c *Client) GetAddressInfo(
 ) (res *AddressInfoResponse, err error) {
 	res = &AddressInfoResponse{}
 	res2, err := c.GetAddressesInfo(ctx, []Address{addr}, opts)
+	res.ResponseMeta = res2.ResponseMeta
 	if err != nil {
 		return
 	}
-	if len(res2.Data) == 1 {
-		res.Data = &res2.Data[0]
-	}
+	res.Data, err = firstItem(res2.Data, "address %s", addr)
 	return
 }
 
@@ -136,11 +342,19 @@ func (c *Client) GetAddressesInfo(
 		res.applyError(nil, ErrNoAddressesProvided)
 		return
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/address_info", addressesPL(addr), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_addresses", addr)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/address_info", addressesPL(addr), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -160,6 +374,13 @@ func (c *Client) GetAddressTxs(
 		return res, err
 	}
 
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_addresses", addrs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
 	var payload = struct {
 		Adresses         []Address `json:"_addresses"`
 		AfterBlockHeight uint64    `json:"_after_block_height,omitempty"`
@@ -174,12 +395,12 @@ func (c *Client) GetAddressTxs(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/address_txs", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/address_txs", rpipe, opts)
 	if err != nil {
 		return res, err
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return res, err
 }
 
@@ -198,14 +419,11 @@ func (c *Client) GetAddressAssets(
 	}
 
 	rsp, err := c.GetAddressesAssets(ctx, []Address{addr}, opts)
+	res.ResponseMeta = rsp.ResponseMeta
 	if err != nil {
 		return
 	}
-	if len(rsp.Data) == 1 {
-		res.Data = &rsp.Data[0]
-	} else {
-		return nil, fmt.Errorf("%w: no assets on address %s", ErrNoData, addr)
-	}
+	res.Data, err = firstItem(rsp.Data, "no assets on address %s", addr)
 	return
 }
 
@@ -221,16 +439,23 @@ func (c *Client) GetAddressesAssets(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/address_assets", addressesPL(addrs), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_addresses", addrs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/address_assets", addressesPL(addrs), opts)
 	if err != nil {
 		return res, err
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	if err != nil {
 		return res, err
 	}
 	if len(res.Data) == 0 {
-		return nil, fmt.Errorf("%w: no assets on %d addresses", ErrNoData, len(addrs))
+		return res, fmt.Errorf("%w: no assets on %d addresses", ErrNoData, len(addrs))
 	}
 	return res, err
 }
@@ -251,6 +476,13 @@ func (c *Client) GetCredentialTxs(
 		return
 	}
 
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_payment_credentials", creds)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
 	var payload = struct {
 		Credentials      []PaymentCredential `json:"_payment_credentials"`
 		AfterBlockHeight uint64              `json:"_after_block_height,omitempty"`
@@ -265,10 +497,10 @@ func (c *Client) GetCredentialTxs(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/credential_txs", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/credential_txs", rpipe, opts)
 	res.applyError(nil, err)
 
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func (c *Client) GetAddressUTxOs(
@@ -284,6 +516,13 @@ func (c *Client) GetAddressUTxOs(
 		return res, err
 	}
 
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_addresses", addrs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
 	var payload = struct {
 		Adresses []Address `json:"_addresses"`
 		Extended bool      `json:"_extended,omitempty"`
@@ -298,9 +537,9 @@ func (c *Client) GetAddressUTxOs(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/address_utxos", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/address_utxos", rpipe, opts)
 	res.applyError(nil, err)
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return res, err
 }
 
@@ -316,6 +555,14 @@ func (c *Client) GetCredentialUTxOs(
 		res.applyError(nil, err)
 		return res, err
 	}
+
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_payment_credentials", creds)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
 	var payload = struct {
 		Creds    []PaymentCredential `json:"_payment_credentials"`
 		Extended bool                `json:"_extended,omitempty"`
@@ -329,9 +576,53 @@ func (c *Client) GetCredentialUTxOs(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/credential_utxos", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/credential_utxos", rpipe, opts)
 	res.applyError(nil, err)
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return res, err
+}
+
+// GetAddressOutputs returns basic transaction output info for given addresses,
+// optionally filtering after specified block height (inclusive).
+func (c *Client) GetAddressOutputs(
+	ctx context.Context,
+	addrs []Address,
+	h uint64,
+	opts *RequestOptions,
+) (*UTxOsResponse, error) {
+	res := &UTxOsResponse{}
+	if len(addrs) == 0 {
+		err := ErrNoAddress
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_addresses", addrs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	var payload = struct {
+		Adresses         []Address `json:"_addresses"`
+		AfterBlockHeight uint64    `json:"_after_block_height,omitempty"`
+	}{
+		Adresses:         addrs,
+		AfterBlockHeight: h,
+	}
+
+	rpipe, w := io.Pipe()
+	go func() {
+		_ = json.NewEncoder(w).Encode(payload)
+		defer w.Close()
+	}()
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/address_outputs", rpipe, opts)
+	if err != nil {
+		return res, err
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return res, err
 }
 
@@ -346,3 +637,168 @@ func addressesPL(addrs []Address) io.Reader {
 	}()
 	return rpipe
 }
+
+func decodeByron(s string) (*DecodedAddress, error) {
+	b, err := decodeByronAddress(s)
+	if err != nil {
+		if errors.Is(err, ErrAddress) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", ErrAddress, err.Error())
+	}
+	raw, _ := base58Decode(s)
+	d := &DecodedAddress{
+		Kind:          AddressKindByron,
+		Header:        0x80,
+		NetworkID:     NetworkIDMainnet,
+		ProtocolMagic: b.protocolMagic,
+		Raw:           raw,
+	}
+	if b.protocolMagic != nil {
+		d.NetworkID = NetworkIDTestnet
+	}
+	return d, nil
+}
+
+func decodeShelley(hrp string, raw []byte) (*DecodedAddress, error) {
+	if len(raw) < 1+credentialLen {
+		return nil, fmt.Errorf("%w: address too short", ErrAddress)
+	}
+	d := &DecodedAddress{
+		Header:    raw[0],
+		NetworkID: NetworkID(raw[0] & 0x0f),
+		HRP:       hrp,
+		Raw:       raw,
+	}
+	typ := raw[0] >> 4
+	payload := raw[1:]
+	switch typ {
+	case 0, 1, 2, 3:
+		if len(payload) != 2*credentialLen {
+			return nil, fmt.Errorf("%w: invalid base address length", ErrAddress)
+		}
+		d.Kind = AddressKindBase
+		d.Payment = PaymentCredential(hex.EncodeToString(payload[:credentialLen]))
+		d.PaymentScript = typ&1 == 1
+		d.Stake = StakeCredential(hex.EncodeToString(payload[credentialLen:]))
+		d.StakeScript = typ&2 == 2
+	case 4, 5:
+		ptr, err := decodePointer(payload[credentialLen:])
+		if err != nil {
+			return nil, err
+		}
+		d.Kind = AddressKindPointer
+		d.Payment = PaymentCredential(hex.EncodeToString(payload[:credentialLen]))
+		d.PaymentScript = typ == 5
+		d.Pointer = ptr
+	case 6, 7:
+		if len(payload) != credentialLen {
+			return nil, fmt.Errorf("%w: invalid enterprise address length", ErrAddress)
+		}
+		d.Kind = AddressKindEnterprise
+		d.Payment = PaymentCredential(hex.EncodeToString(payload))
+		d.PaymentScript = typ == 7
+	case 14, 15:
+		if len(payload) != credentialLen {
+			return nil, fmt.Errorf("%w: invalid reward address length", ErrAddress)
+		}
+		d.Kind = AddressKindReward
+		d.Stake = StakeCredential(hex.EncodeToString(payload))
+		d.StakeScript = typ == 15
+	default:
+		return nil, fmt.Errorf("%w: unsupported address header type %d", ErrAddress, typ)
+	}
+	if want := addressHRP(d.Kind, d.NetworkID); hrp != want {
+		return nil, fmt.Errorf("%w: expected prefix %s got %s", ErrAddress, want, hrp)
+	}
+	return d, nil
+}
+
+// decodePointer decodes stake pointer encoded as variable length natural numbers.
+func decodePointer(b []byte) (*AddressPointer, error) {
+	var nums [3]uint64
+	for i := range nums {
+		var n uint64
+		for j := 0; ; j++ {
+			if len(b) == 0 || j > 9 {
+				return nil, fmt.Errorf("%w: invalid pointer", ErrAddress)
+			}
+			if n>>57 != 0 {
+				return nil, fmt.Errorf("%w: pointer value overflows 64 bits", ErrAddress)
+			}
+			c := b[0]
+			b = b[1:]
+			n = n<<7 | uint64(c&0x7f)
+			if c&0x80 == 0 {
+				break
+			}
+		}
+		nums[i] = n
+	}
+	if len(b) != 0 {
+		return nil, fmt.Errorf("%w: trailing bytes after pointer", ErrAddress)
+	}
+	return &AddressPointer{
+		Slot:      Slot(nums[0]),
+		TxIndex:   nums[1],
+		CertIndex: nums[2],
+	}, nil
+}
+
+// addressHRP returns bech32 human readable part for address of given kind.
+func addressHRP(kind AddressKind, net NetworkID) string {
+	if kind == AddressKindReward {
+		if net == NetworkIDMainnet {
+			return hrpStake
+		}
+		return hrpStakeTest
+	}
+	if net == NetworkIDMainnet {
+		return hrpAddr
+	}
+	return hrpAddrTest
+}
+
+// rewardType returns header type of reward address.
+func rewardType(script bool) byte {
+	if script {
+		return 15
+	}
+	return 14
+}
+
+// encodeAddress encodes Shelley address with given header type,
+// network id and credentials.
+func encodeAddress(typ byte, net NetworkID, creds ...[]byte) (Address, error) {
+	if net > 0x0f {
+		return "", fmt.Errorf("%w: network id %d out of range", ErrAddress, net)
+	}
+	header := typ<<4 | byte(net)
+	raw := []byte{header}
+	for _, c := range creds {
+		raw = append(raw, c...)
+	}
+	d, err := decodeShelley(addressHRP(headerKind(header), NetworkID(header&0x0f)), raw)
+	if err != nil {
+		return "", err
+	}
+	s, err := bech32Encode(d.HRP, raw)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrAddress, err.Error())
+	}
+	return Address(s), nil
+}
+
+func headerKind(header byte) AddressKind {
+	switch header >> 4 {
+	case 0, 1, 2, 3:
+		return AddressKindBase
+	case 4, 5:
+		return AddressKindPointer
+	case 6, 7:
+		return AddressKindEnterprise
+	case 14, 15:
+		return AddressKindReward
+	}
+	return AddressKindUnknown
+}
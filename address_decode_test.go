@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"hash/crc32"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// CIP-19 test vectors.
+const (
+	cip19PaymentKeyHash = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	cip19StakeKeyHash   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	cip19ScriptHash     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+func TestAddressDecodeCIP19(t *testing.T) {
+	tests := []struct {
+		addr          Address
+		kind          AddressKind
+		net           NetworkID
+		payment       PaymentCredential
+		paymentScript bool
+		stake         StakeCredential
+		stakeScript   bool
+		pointer       *AddressPointer
+	}{
+		{
+			addr:    "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			kind:    AddressKindBase,
+			net:     NetworkIDMainnet,
+			payment: cip19PaymentKeyHash,
+			stake:   cip19StakeKeyHash,
+		},
+		{
+			addr:          "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			kind:          AddressKindBase,
+			net:           NetworkIDMainnet,
+			payment:       cip19ScriptHash,
+			paymentScript: true,
+			stake:         cip19StakeKeyHash,
+		},
+		{
+			addr:        "addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			kind:        AddressKindBase,
+			net:         NetworkIDMainnet,
+			payment:     cip19PaymentKeyHash,
+			stake:       cip19ScriptHash,
+			stakeScript: true,
+		},
+		{
+			addr:          "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g",
+			kind:          AddressKindBase,
+			net:           NetworkIDMainnet,
+			payment:       cip19ScriptHash,
+			paymentScript: true,
+			stake:         cip19ScriptHash,
+			stakeScript:   true,
+		},
+		{
+			addr:    "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+			kind:    AddressKindPointer,
+			net:     NetworkIDMainnet,
+			payment: cip19PaymentKeyHash,
+			pointer: &AddressPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3},
+		},
+		{
+			addr:          "addr128phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtupnz75xxcrtw79hu",
+			kind:          AddressKindPointer,
+			net:           NetworkIDMainnet,
+			payment:       cip19ScriptHash,
+			paymentScript: true,
+			pointer:       &AddressPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3},
+		},
+		{
+			addr:    "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			kind:    AddressKindEnterprise,
+			net:     NetworkIDMainnet,
+			payment: cip19PaymentKeyHash,
+		},
+		{
+			addr:          "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+			kind:          AddressKindEnterprise,
+			net:           NetworkIDMainnet,
+			payment:       cip19ScriptHash,
+			paymentScript: true,
+		},
+		{
+			addr:  "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			kind:  AddressKindReward,
+			net:   NetworkIDMainnet,
+			stake: cip19StakeKeyHash,
+		},
+		{
+			addr:        "stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5",
+			kind:        AddressKindReward,
+			net:         NetworkIDMainnet,
+			stake:       cip19ScriptHash,
+			stakeScript: true,
+		},
+		{
+			addr:    "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+			kind:    AddressKindBase,
+			net:     NetworkIDTestnet,
+			payment: cip19PaymentKeyHash,
+			stake:   cip19StakeKeyHash,
+		},
+		{
+			addr:    "addr_test1gz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrdw5vky",
+			kind:    AddressKindPointer,
+			net:     NetworkIDTestnet,
+			payment: cip19PaymentKeyHash,
+			pointer: &AddressPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3},
+		},
+		{
+			addr:    "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+			kind:    AddressKindEnterprise,
+			net:     NetworkIDTestnet,
+			payment: cip19PaymentKeyHash,
+		},
+		{
+			addr:  "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+			kind:  AddressKindReward,
+			net:   NetworkIDTestnet,
+			stake: cip19StakeKeyHash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr.String(), func(t *testing.T) {
+			d, err := tt.addr.Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Kind != tt.kind {
+				t.Errorf("kind: expected %s got %s", tt.kind, d.Kind)
+			}
+			if d.NetworkID != tt.net {
+				t.Errorf("network id: expected %d got %d", tt.net, d.NetworkID)
+			}
+			if d.Payment != tt.payment || d.PaymentScript != tt.paymentScript {
+				t.Errorf("payment: expected %s (script %t) got %s (script %t)",
+					tt.payment, tt.paymentScript, d.Payment, d.PaymentScript)
+			}
+			if d.Stake != tt.stake || d.StakeScript != tt.stakeScript {
+				t.Errorf("stake: expected %s (script %t) got %s (script %t)",
+					tt.stake, tt.stakeScript, d.Stake, d.StakeScript)
+			}
+			if (d.Pointer == nil) != (tt.pointer == nil) ||
+				(d.Pointer != nil && *d.Pointer != *tt.pointer) {
+				t.Errorf("pointer: expected %v got %v", tt.pointer, d.Pointer)
+			}
+		})
+	}
+}
+
+func TestAddressDecodeByron(t *testing.T) {
+	tests := []struct {
+		addr  Address
+		net   NetworkID
+		magic *uint32
+	}{
+		{
+			addr: "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi",
+			net:  NetworkIDMainnet,
+		},
+		{
+			// preprod address with protocol magic attribute 1.
+			addr:  "FHnt4NL7yPXh7nSaGY1gHFPRYXL7oJnV4vyiif7gMV7zt4S1pmuLvDKjFrfX15D",
+			net:   NetworkIDTestnet,
+			magic: &PreProd.Magic,
+		},
+		{
+			addr:  mustEncodeByron(math.MaxUint32),
+			net:   NetworkIDTestnet,
+			magic: func() *uint32 { m := uint32(math.MaxUint32); return &m }(),
+		},
+		{
+			// legacy testnet address with protocol magic attribute 1097911063.
+			addr:  "2cWKMJemoBahD5XEQkWqkSYS2ou6ex4zvJvCCPNfziZsNgNcybXGVxn7DaYNArA92hZh3",
+			net:   NetworkIDTestnet,
+			magic: func() *uint32 { m := uint32(1097911063); return &m }(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr.String(), func(t *testing.T) {
+			d, err := tt.addr.Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.Kind != AddressKindByron {
+				t.Errorf("kind: expected %s got %s", AddressKindByron, d.Kind)
+			}
+			if d.NetworkID != tt.net {
+				t.Errorf("network id: expected %d got %d", tt.net, d.NetworkID)
+			}
+			switch {
+			case tt.magic == nil && d.ProtocolMagic != nil:
+				t.Errorf("protocol magic: expected none got %d", *d.ProtocolMagic)
+			case tt.magic != nil && d.ProtocolMagic == nil:
+				t.Errorf("protocol magic: expected %d got none", *tt.magic)
+			case tt.magic != nil && *d.ProtocolMagic != *tt.magic:
+				t.Errorf("protocol magic: expected %d got %d", *tt.magic, *d.ProtocolMagic)
+			}
+		})
+	}
+}
+
+func TestAddressDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		addr Address
+	}{
+		{"bad checksum", "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl9"},
+		{"wrong hrp", "stake1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"},
+		{"testnet hrp on mainnet address", mustEncodeAddress(t, "addr_test", "61"+cip19PaymentKeyHash)},
+		{"mainnet hrp on testnet address", mustEncodeAddress(t, "addr", "60"+cip19PaymentKeyHash)},
+		{"reward hrp on enterprise address", mustEncodeAddress(t, "stake", "61"+cip19PaymentKeyHash)},
+		{"short base address", mustEncodeAddress(t, "addr", "01"+cip19PaymentKeyHash)},
+		{"unsupported header", mustEncodeAddress(t, "addr", "91"+cip19PaymentKeyHash)},
+		{"byron bad checksum", "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAj"},
+		{"invalid base58", "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMA0"},
+		{"byron protocol magic above uint32", mustEncodeByron(math.MaxUint32 + 1)},
+		{"byron protocol magic max uint64", mustEncodeByron(math.MaxUint64)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.addr.Decode()
+			if !errors.Is(err, ErrAddress) {
+				t.Errorf("expected %v got %v", ErrAddress, err)
+			}
+		})
+	}
+}
+
+func TestDecodePointer(t *testing.T) {
+	tests := []struct {
+		name string
+		ptr  string
+		want *AddressPointer
+	}{
+		{"cip19", "8198bd431b03", &AddressPointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}},
+		{"max uint64", "81ffffffffffffffff7f" + "0000", &AddressPointer{Slot: 1<<64 - 1}},
+		{"overflow", "82808080808080808000" + "0000", nil},
+		{"too long", "8080808080808080808000" + "0000", nil},
+		{"truncated", "8198bd431b", nil},
+		{"trailing bytes", "8198bd431b0300", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePointer(mustHex(t, tt.ptr))
+			if tt.want == nil {
+				if !errors.Is(err, ErrAddress) {
+					t.Errorf("expected %v got %v (%v)", ErrAddress, err, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *got != *tt.want {
+				t.Errorf("expected %v got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func mustEncodeAddress(t *testing.T, hrp, raw string) Address {
+	t.Helper()
+	s, err := bech32Encode(hrp, mustHex(t, raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Address(s)
+}
+
+// mustEncodeByron returns base58 Byron address with given protocol magic attribute.
+func mustEncodeByron(magic uint64) Address {
+	m := &cborWriter{}
+	m.head(cborUint, magic)
+
+	p := &cborWriter{}
+	p.head(cborArray, 3)
+	p.bytes(make([]byte, 28))
+	p.head(cborMap, 1)
+	p.head(cborUint, 2)
+	p.bytes(m.b)
+	p.head(cborUint, 0)
+
+	w := &cborWriter{}
+	w.head(cborArray, 2)
+	w.head(cborTag, 24)
+	w.bytes(p.b)
+	w.head(cborUint, uint64(crc32.ChecksumIEEE(p.b)))
+
+	var (
+		n    = new(big.Int).SetBytes(w.b)
+		base = big.NewInt(58)
+		mod  = new(big.Int)
+		out  []byte
+	)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append([]byte{base58Alphabet[mod.Int64()]}, out...)
+	}
+	return Address(out)
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// CIP-19 verification keys of cip19PaymentKeyHash and cip19StakeKeyHash.
+const (
+	cip19PaymentVKey = "73fea80d424276ad0978d4fe5310e8bc2d485f5f6bb3bf87612989f112ad5a7d"
+	cip19StakeVKey   = "09ab278d49b7b86a055185c474c4942281ddfa05a54684c7e8a6f230625aee57"
+)
+
+func TestNewAddressCIP19(t *testing.T) {
+	paymentKey := mustCredential(t, paymentVKeyEnvelope(cip19PaymentVKey))
+	stakeKey := mustCredential(t, stakeVKeyEnvelope(cip19StakeVKey))
+	script, err := ScriptHashCredential(cip19ScriptHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	base := func(payment, stake Credential) func(NetworkID) (Address, error) {
+		return func(net NetworkID) (Address, error) { return NewBaseAddress(net, payment, stake) }
+	}
+	enterprise := func(payment Credential) func(NetworkID) (Address, error) {
+		return func(net NetworkID) (Address, error) { return NewEnterpriseAddress(net, payment) }
+	}
+	reward := func(stake Credential) func(NetworkID) (Address, error) {
+		return func(net NetworkID) (Address, error) { return NewRewardAddress(net, stake) }
+	}
+
+	tests := []struct {
+		name    string
+		header  byte
+		new     func(NetworkID) (Address, error)
+		mainnet Address
+		testnet Address
+	}{
+		{
+			name:    "base key key",
+			header:  0x00,
+			new:     base(paymentKey, stakeKey),
+			mainnet: "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			testnet: "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+		},
+		{
+			name:    "base script key",
+			header:  0x10,
+			new:     base(script, stakeKey),
+			mainnet: "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			testnet: "addr_test1zrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgsxj90mg",
+		},
+		{
+			name:    "base key script",
+			header:  0x20,
+			new:     base(paymentKey, script),
+			mainnet: "addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			testnet: "addr_test1yz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shsf5r8qx",
+		},
+		{
+			name:    "base script script",
+			header:  0x30,
+			new:     base(script, script),
+			mainnet: "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g",
+			testnet: "addr_test1xrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs4p04xh",
+		},
+		{
+			name:    "enterprise key",
+			header:  0x60,
+			new:     enterprise(paymentKey),
+			mainnet: "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			testnet: "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+		},
+		{
+			name:    "enterprise script",
+			header:  0x70,
+			new:     enterprise(script),
+			mainnet: "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+			testnet: "addr_test1wrphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcl6szpr",
+		},
+		{
+			name:    "reward key",
+			header:  0xe0,
+			new:     reward(stakeKey),
+			mainnet: "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			testnet: "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+		},
+		{
+			name:    "reward script",
+			header:  0xf0,
+			new:     reward(script),
+			mainnet: "stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5",
+			testnet: "stake_test17rphkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcljw6kf",
+		},
+	}
+	for _, tt := range tests {
+		for _, net := range []NetworkID{NetworkIDMainnet, NetworkIDTestnet} {
+			want := tt.testnet
+			if net == NetworkIDMainnet {
+				want = tt.mainnet
+			}
+			t.Run(want.String(), func(t *testing.T) {
+				addr, err := tt.new(net)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if addr != want {
+					t.Fatalf("expected %s got %s", want, addr)
+				}
+				d, err := addr.Decode()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d.Header != tt.header|byte(net) || d.NetworkID != net {
+					t.Errorf("expected header %#02x got %#02x", tt.header|byte(net), d.Header)
+				}
+				// decoded credentials build the same address.
+				var rebuilt Address
+				switch d.Kind {
+				case AddressKindBase:
+					rebuilt, err = NewBaseAddress(d.NetworkID,
+						decodedCredential(t, d.Payment.String(), d.PaymentScript),
+						decodedCredential(t, d.Stake.String(), d.StakeScript))
+				case AddressKindEnterprise:
+					rebuilt, err = NewEnterpriseAddress(d.NetworkID, decodedCredential(t, d.Payment.String(), d.PaymentScript))
+				case AddressKindReward:
+					rebuilt, err = NewRewardAddress(d.NetworkID, decodedCredential(t, d.Stake.String(), d.StakeScript))
+				}
+				if err != nil || rebuilt != addr {
+					t.Errorf("expected %s from decoded address got %s (%v)", addr, rebuilt, err)
+				}
+			})
+		}
+	}
+}
+
+func TestVKeyCredential(t *testing.T) {
+	payment := mustCredential(t, paymentVKeyEnvelope(cip19PaymentVKey))
+	if payment.Hex() != cip19PaymentKeyHash || payment.Script {
+		t.Errorf("expected key credential %s got %s", cip19PaymentKeyHash, payment.Hex())
+	}
+	// extended key with chain code hashes to the same credential.
+	extended := []byte(`{"type":"PaymentExtendedVerificationKeyShelley_ed25519_bip32","cborHex":"5840` +
+		cip19PaymentVKey + cip19StakeVKey + `"}`)
+	if c := mustCredential(t, extended); !reflect.DeepEqual(c, payment) {
+		t.Errorf("expected %s got %s", payment.Hex(), c.Hex())
+	}
+	if got := payment.PaymentCredential(); got != cip19PaymentKeyHash {
+		t.Errorf("expected payment credential %s got %s", cip19PaymentKeyHash, got)
+	}
+	if got := mustCredential(t, stakeVKeyEnvelope(cip19StakeVKey)).StakeCredential(); got != cip19StakeKeyHash {
+		t.Errorf("expected stake credential %s got %s", cip19StakeKeyHash, got)
+	}
+
+	invalid := map[string]string{
+		"not json":     `{`,
+		"signing key":  `{"type":"PaymentSigningKeyShelley_ed25519","cborHex":"5820` + cip19PaymentVKey + `"}`,
+		"invalid hex":  `{"type":"PaymentVerificationKeyShelley_ed25519","cborHex":"zz"}`,
+		"not bytes":    `{"type":"PaymentVerificationKeyShelley_ed25519","cborHex":"01"}`,
+		"short key":    `{"type":"PaymentVerificationKeyShelley_ed25519","cborHex":"5801aa"}`,
+		"trailing":     `{"type":"PaymentVerificationKeyShelley_ed25519","cborHex":"5820` + cip19PaymentVKey + `00"}`,
+		"empty object": `{}`,
+	}
+	for name, env := range invalid {
+		if _, err := VKeyCredential([]byte(env)); !errors.Is(err, ErrCredential) {
+			t.Errorf("%s: expected ErrCredential got %v", name, err)
+		}
+	}
+	if _, err := ScriptHashCredential(ScriptHash(cip19ScriptHash[2:])); !errors.Is(err, ErrCredential) {
+		t.Errorf("expected ErrCredential for short script hash got %v", err)
+	}
+}
+
+// paymentVKeyEnvelope returns cardano-cli text envelope of payment key.
+func paymentVKeyEnvelope(key string) []byte {
+	return []byte(`{"type":"PaymentVerificationKeyShelley_ed25519","description":"Payment Verification Key","cborHex":"5820` + key + `"}`)
+}
+
+// stakeVKeyEnvelope returns cardano-cli text envelope of stake key.
+func stakeVKeyEnvelope(key string) []byte {
+	return []byte(`{"type":"StakeVerificationKeyShelley_ed25519","description":"Stake Verification Key","cborHex":"5820` + key + `"}`)
+}
+
+func mustCredential(t *testing.T, envelope []byte) Credential {
+	t.Helper()
+	c, err := VKeyCredential(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func decodedCredential(t *testing.T, hash string, script bool) Credential {
+	t.Helper()
+	return Credential{Hash: mustHex(t, hash), Script: script}
+}
@@ -6,9 +6,14 @@ package koios
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/shopspring/decimal"
 )
@@ -19,6 +24,9 @@ type (
 	// AssetName defines type for _asset_name.
 	AssetName string
 
+	// AssetLabel is CIP-67 asset name label.
+	AssetLabel uint16
+
 	// AssetFingerprint defines type for asset_fingerprint.
 	// The CIP14 fingerprint of the asset,
 	// This specification defines a user-facing asset fingerprint
@@ -122,10 +130,7 @@ type (
 	}
 
 	// AssetListResponse represents response from `/asset_list` endpoint.
-	AssetListResponse struct {
-		Response
-		Data []AssetListItem `json:"data"`
-	}
+	AssetListResponse = ListResponse[AssetListItem]
 
 	// AssetHolder payment addresses holding the given token (including balance).
 	AssetHolder struct {
@@ -135,38 +140,20 @@ type (
 	}
 
 	// AssetAddressListResponse represents response from `/policy_asset_addresses` endpoint.
-	AssetAddressListResponse struct {
-		Response
-		Data []AssetHolder `json:"data"`
-	}
-	AssetNFTAddressResponse struct {
-		Response
-		Data *Address `json:"data"`
-	}
+	AssetAddressListResponse = ListResponse[AssetHolder]
+	AssetNFTAddressResponse  = Response[*Address]
 
 	// AssetInfoResponse represents response from `/asset_info` endpoint.
-	AssetInfoResponse struct {
-		Data []AssetInfo `json:"data"`
-		Response
-	}
+	AssetInfoResponse = ListResponse[AssetInfo]
 
 	// AssetSummaryResponse represents response from `/asset_summary` endpoint.
-	AssetSummaryResponse struct {
-		Response
-		Data *AssetSummary `json:"data"`
-	}
+	AssetSummaryResponse = Response[*AssetSummary]
 
 	// AssetTxsResponse represents response from `/asset_txs` endpoint.
-	AssetTxsResponse struct {
-		Response
-		Data []AddressTx `json:"data"`
-	}
+	AssetTxsResponse = ListResponse[AddressTx]
 
 	// AssetPolicyInfoResponse represents response from `/asset_policy_info` endpoint.
-	AssetPolicyInfoResponse struct {
-		Response
-		Data []AssetInfo `json:"data"`
-	}
+	AssetPolicyInfoResponse = ListResponse[AssetInfo]
 
 	// AssetMintTX holds specific mint tx hash and amount.
 	AssetMintTX struct {
@@ -183,32 +170,17 @@ type (
 	}
 
 	// AssetHistoryResponse represents response from `/asset_history` endpoint.
-	AssetHistoryResponse struct {
-		Response
-		Data *AssetHistory `json:"data"`
-	}
+	AssetHistoryResponse = Response[*AssetHistory]
 
 	// AssetPolicyAssetListResponse represents response from `/policy_asset_list` endpoint.
-	AssetPolicyAssetListResponse struct {
-		Response
-		Data []PolicyAssetListItem `json:"data"`
-	}
+	AssetPolicyAssetListResponse = ListResponse[PolicyAssetListItem]
 
 	// AssetTokenRegistryResponse represents response from `/asset_token_registry` endpoint.
-	AssetTokenRegistryResponse struct {
-		Response
-		Data []TokenRegistryMetadata `json:"data"`
-	}
+	AssetTokenRegistryResponse = ListResponse[TokenRegistryMetadata]
 
-	AssetUTxOsResponse struct {
-		Response
-		Data []UTxO `json:"data"`
-	}
+	AssetUTxOsResponse = ListResponse[UTxO]
 
-	PolicyAssetMintsResponse struct {
-		Response
-		Data []PolicyAssetMint `json:"data"`
-	}
+	PolicyAssetMintsResponse = ListResponse[PolicyAssetMint]
 
 	PolicyAssetMint struct {
 		AssetName      AssetName        `json:"asset_name"`
@@ -223,27 +195,309 @@ type (
 	}
 )
 
+const (
+	hrpAsset = "asset"
+	// policyIDLen is length of policy id (script hash) in bytes.
+	policyIDLen = 28
+	// maxAssetNameLen is max length of asset name in bytes.
+	maxAssetNameLen = 32
+	// fingerprintLen is length of CIP-14 fingerprint digest in bytes.
+	fingerprintLen = 20
+)
+
 // String returns AssetName as string.
 func (v AssetName) String() string {
 	return string(v)
 }
 
+// CIP-67 labels used by CIP-68 tokens.
+const (
+	AssetLabelReference AssetLabel = 100
+	AssetLabelNFT       AssetLabel = 222
+	AssetLabelFT        AssetLabel = 333
+	AssetLabelRFT       AssetLabel = 444
+)
+
+// assetLabelLen is length of CIP-67 label prefix in bytes.
+const assetLabelLen = 4
+
+// UTF8 returns asset name decoded as UTF-8 string.
+// It returns false when asset name is not valid hex or
+// decoded bytes are not valid UTF-8 or contain control characters.
+func (v AssetName) UTF8() (string, bool) {
+	b, err := v.bytes()
+	if err != nil || !utf8.Valid(b) {
+		return "", false
+	}
+	s := string(b)
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return "", false
+		}
+	}
+	return s, true
+}
+
+// DisplayName returns UTF-8 decoded base name (without CIP-67 label)
+// or hex encoded base name when it is not valid UTF-8.
+func (v AssetName) DisplayName() string {
+	base := v.BaseName()
+	if s, ok := base.UTF8(); ok {
+		return s
+	}
+	return base.String()
+}
+
+// Label returns CIP-67 label of the asset name. It returns false when
+// asset name has no label prefix or label checksum is invalid.
+func (v AssetName) Label() (AssetLabel, bool) {
+	b, err := v.bytes()
+	if err != nil || len(b) < assetLabelLen {
+		return 0, false
+	}
+	// [0000 | 16 bits label | 8 bits crc8 | 0000]
+	if b[0]&0xf0 != 0 || b[3]&0x0f != 0 {
+		return 0, false
+	}
+	n := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	label := AssetLabel(n >> 12)
+	if byte(n>>4) != label.crc8() {
+		return 0, false
+	}
+	return label, true
+}
+
+// BaseName returns asset name without CIP-67 label prefix.
+func (v AssetName) BaseName() AssetName {
+	if _, ok := v.Label(); ok {
+		return v[assetLabelLen*2:]
+	}
+	return v
+}
+
+// WithLabel returns asset name with CIP-67 label prefix
+// replacing existing label if any.
+func (v AssetName) WithLabel(label AssetLabel) (AssetName, error) {
+	return NewLabeledAssetName(label, v.BaseName())
+}
+
+// ReferenceName returns name of CIP-68 reference token (label 100)
+// paired with this asset.
+func (v AssetName) ReferenceName() (AssetName, error) {
+	if _, ok := v.Label(); !ok {
+		return "", fmt.Errorf("%w: asset name %s has no CIP-67 label", ErrAsset, v)
+	}
+	return v.WithLabel(AssetLabelReference)
+}
+
+// NewLabeledAssetName returns hex encoded asset name of base name
+// prefixed with CIP-67 label.
+func NewLabeledAssetName(label AssetLabel, base AssetName) (AssetName, error) {
+	b, err := base.bytes()
+	if err != nil {
+		return "", err
+	}
+	if len(b)+assetLabelLen > maxAssetNameLen {
+		return "", fmt.Errorf("%w: labeled asset name must be max %d bytes", ErrAsset, maxAssetNameLen)
+	}
+	return AssetName(label.Prefix() + strings.ToLower(base.String())), nil
+}
+
+// Prefix returns hex encoded CIP-67 asset name prefix of the label.
+func (l AssetLabel) Prefix() string {
+	return fmt.Sprintf("0%04x%02x0", uint16(l), l.crc8())
+}
+
+// String returns label as decimal string.
+func (l AssetLabel) String() string {
+	return strconv.Itoa(int(l))
+}
+
+// crc8 returns CRC-8 (polynomial 0x07) of big endian label bytes.
+func (l AssetLabel) crc8() byte {
+	var crc byte
+	for _, b := range []byte{byte(l >> 8), byte(l)} {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
 // String returns AssetFingerprint as string.
 func (v AssetFingerprint) String() string {
 	return string(v)
 }
 
+// Valid validates asset fingerprint and returns false and error
+// if fingerprint is invalid otherwise it returns true, nil.
+func (v AssetFingerprint) Valid() (bool, error) {
+	hrp, b, err := bech32Decode(v.String())
+	if err != nil {
+		return false, fmt.Errorf("%w: fingerprint %s", ErrAsset, err.Error())
+	}
+	if hrp != hrpAsset || len(b) != fingerprintLen {
+		return false, fmt.Errorf("%w: invalid fingerprint %s", ErrAsset, v)
+	}
+	return true, nil
+}
+
+// Valid validates policy id and returns false and error
+// if policy id is invalid otherwise it returns true, nil.
+func (v PolicyID) Valid() (bool, error) {
+	if _, err := v.bytes(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (v PolicyID) bytes() ([]byte, error) {
+	if len(v) == 0 {
+		return nil, ErrNoPolicyID
+	}
+	b, err := hex.DecodeString(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrPolicyID, err.Error())
+	}
+	if len(b) != policyIDLen {
+		return nil, fmt.Errorf("%w: policy id must be %d bytes got %d", ErrPolicyID, policyIDLen, len(b))
+	}
+	return b, nil
+}
+
+func (v AssetName) bytes() ([]byte, error) {
+	b, err := hex.DecodeString(v.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: asset name must be hex encoded: %s", ErrAsset, err.Error())
+	}
+	if len(b) > maxAssetNameLen {
+		return nil, fmt.Errorf("%w: asset name must be max %d bytes got %d", ErrAsset, maxAssetNameLen, len(b))
+	}
+	return b, nil
+}
+
+// NewAssetFingerprint returns CIP-14 fingerprint of the asset
+// computed from policy id and hex encoded asset name.
+func NewAssetFingerprint(policy PolicyID, name AssetName) (AssetFingerprint, error) {
+	p, err := policy.bytes()
+	if err != nil {
+		return "", err
+	}
+	n, err := name.bytes()
+	if err != nil {
+		return "", err
+	}
+	fp, err := bech32Encode(hrpAsset, blake2bSum(append(p, n...), fingerprintLen))
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrAsset, err.Error())
+	}
+	return AssetFingerprint(fp), nil
+}
+
+// ParseAssetID parses asset identifier in any of the common forms:
+//
+//   - policy.name, policy id and hex encoded asset name separated by dot.
+//   - unit, concatenated hex policy id and asset name.
+//   - fingerprint, CIP-14 asset fingerprint asset1...
+//
+// Returned asset has fingerprint computed when policy id and asset name are known.
+// Fingerprint can not be resolved to policy id and asset name locally,
+// so for fingerprint form only Fingerprint is set.
+func ParseAssetID(id string) (Asset, error) {
+	var asset Asset
+	id = strings.TrimSpace(id)
+	switch {
+	case len(id) == 0:
+		return asset, fmt.Errorf("%w: empty asset id", ErrAsset)
+	case strings.HasPrefix(strings.ToLower(id), hrpAsset+"1"):
+		asset.Fingerprint = AssetFingerprint(strings.ToLower(id))
+		_, err := asset.Fingerprint.Valid()
+		return asset, err
+	case strings.Contains(id, "."):
+		policy, name, _ := strings.Cut(id, ".")
+		asset.PolicyID = PolicyID(policy)
+		asset.AssetName = AssetName(name)
+	default:
+		if len(id) < policyIDLen*2 {
+			return asset, fmt.Errorf("%w: unrecognized asset id %s", ErrAsset, id)
+		}
+		asset.PolicyID = PolicyID(id[:policyIDLen*2])
+		asset.AssetName = AssetName(id[policyIDLen*2:])
+	}
+	asset.PolicyID = PolicyID(strings.ToLower(asset.PolicyID.String()))
+	asset.AssetName = AssetName(strings.ToLower(asset.AssetName.String()))
+	fp, err := NewAssetFingerprint(asset.PolicyID, asset.AssetName)
+	if err != nil {
+		return asset, err
+	}
+	asset.Fingerprint = fp
+	return asset, nil
+}
+
+// Unit returns concatenated hex policy id and asset name.
+func (a Asset) Unit() string {
+	return a.PolicyID.String() + a.AssetName.String()
+}
+
+// resolveAsset returns asset with policy id and asset name set.
+// Assets identified only by fingerprint are resolved using `/asset_list`.
+func (c *Client) resolveAsset(ctx context.Context, asset Asset) (Asset, error) {
+	if asset.PolicyID != "" {
+		if len(asset.PolicyID) > policyIDLen*2 && asset.AssetName == "" {
+			// unit passed as policy id.
+			return ParseAssetID(asset.PolicyID.String())
+		}
+		if _, err := asset.PolicyID.Valid(); err != nil {
+			return asset, err
+		}
+		if err := asset.AssetName.validate(); err != nil {
+			return asset, err
+		}
+		return asset, nil
+	}
+	if asset.Fingerprint == "" {
+		return asset, fmt.Errorf("%w: policy_id and asset_name or fingerprint must be provided", ErrAsset)
+	}
+	if _, err := asset.Fingerprint.Valid(); err != nil {
+		return asset, err
+	}
+	opts := c.NewRequestOptions()
+	opts.QuerySet("fingerprint", "eq."+asset.Fingerprint.String())
+	opts.QuerySet("select", "policy_id,asset_name,fingerprint")
+
+	res := &AssetListResponse{}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_list", nil, opts)
+	if err != nil {
+		return asset, err
+	}
+	if err := ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data); err != nil {
+		return asset, err
+	}
+	item, err := firstItem(res.Data, "asset %s", asset.Fingerprint)
+	if err != nil {
+		return asset, err
+	}
+	asset.PolicyID = item.PolicyID
+	asset.AssetName = item.AssetName
+	return asset, nil
+}
+
 // GetAssetList returns the list of all native assets (paginated).
 func (c *Client) GetAssets(
 	ctx context.Context,
 	opts *RequestOptions,
 ) (res *AssetListResponse, err error) {
 	res = &AssetListResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_list", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -256,23 +510,31 @@ func (c *Client) GetAssetAddresses(
 ) (res *AssetAddressListResponse, err error) {
 	res = &AssetAddressListResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+		v.value("_asset_name", assetName)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 	opts.QuerySet("_asset_name", assetName.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_addresses", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_addresses", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	return
 }
 
 // GetAssetInfo returns the information of an asset including
 // first minting & token registry metadata.
+// Assets can be identified by policy id and asset name, unit set as
+// policy id or by fingerprint only, which is resolved before request.
 func (c *Client) GetAssetInfo(
 	ctx context.Context,
 	assets []Asset,
@@ -280,10 +542,15 @@ func (c *Client) GetAssetInfo(
 ) (res *AssetInfoResponse, err error) {
 	res = &AssetInfoResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.assets("_asset_list", assets)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
 
+	opts = c.requestOptions(opts)
+
 	if len(assets) == 0 {
 		return nil, fmt.Errorf("%w: atleast one asset must be provided", ErrAsset)
 	}
@@ -293,8 +560,9 @@ func (c *Client) GetAssetInfo(
 	}{}
 
 	for _, asset := range assets {
-		if asset.PolicyID == "" || asset.AssetName == "" {
-			return nil, fmt.Errorf("%w: policy_id and asset_name must be provided", ErrAsset)
+		if asset, err = c.resolveAsset(ctx, asset); err != nil {
+			res.applyError(nil, err)
+			return
 		}
 		payload.Assets = append(payload.Assets, []string{asset.PolicyID.String(), asset.AssetName.String()})
 	}
@@ -305,17 +573,19 @@ func (c *Client) GetAssetInfo(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/asset_info", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/asset_info", rpipe, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
 // GetAssetSummary returns the summary of an asset
 // (total transactions exclude minting/total wallets
 // include only wallets with asset balance).
+// When name is empty policy can be any asset identifier
+// accepted by ParseAssetID e.g. unit or fingerprint.
 func (c *Client) GetAssetSummary(
 	ctx context.Context,
 	policy PolicyID,
@@ -324,24 +594,39 @@ func (c *Client) GetAssetSummary(
 ) (res *AssetSummaryResponse, err error) {
 	res = &AssetSummaryResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	asset := Asset{PolicyID: policy, AssetName: name}
+	if name == "" && len(policy) != policyIDLen*2 {
+		if asset, err = ParseAssetID(policy.String()); err != nil {
+			res.applyError(nil, err)
+			return
+		}
+	}
+	if err = c.validate(func(v *validator) {
+		v.asset("_asset_policy", asset)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+	if asset, err = c.resolveAsset(ctx, asset); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+	policy, name = asset.PolicyID, asset.AssetName
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 	opts.QuerySet("_asset_name", name.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_summary", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_summary", nil, opts)
 	if err != nil {
 		return
 	}
 
 	var assetSummary []AssetSummary
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &assetSummary)
-	if len(assetSummary) == 1 {
-		data := assetSummary[0]
-		res.Data = &data
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &assetSummary); err != nil {
+		return
 	}
-
+	res.Data, err = firstItem(assetSummary, "asset summary %s.%s", policy, name)
 	return
 }
 
@@ -356,9 +641,15 @@ func (c *Client) GetAssetTxs(
 ) (res *AssetTxsResponse, err error) {
 	res = &AssetTxsResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+		v.value("_asset_name", name)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 	if len(name) > 0 {
 		opts.QuerySet("_asset_name", name.String())
@@ -369,12 +660,12 @@ func (c *Client) GetAssetTxs(
 	if history {
 		opts.QuerySet("_history", fmt.Sprint(history))
 	}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_txs", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_txs", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	return
 }
@@ -387,17 +678,22 @@ func (c *Client) GetPolicyAssetInfo(
 ) (res *AssetPolicyInfoResponse, err error) {
 	res = &AssetPolicyInfoResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/policy_asset_info", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/policy_asset_info", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	for i := range res.Data {
 		res.Data[i].PolicyID = policy
@@ -413,24 +709,30 @@ func (c *Client) GetAssetHistory(
 	opts *RequestOptions,
 ) (res *AssetHistoryResponse, err error) {
 	res = &AssetHistoryResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+		v.value("_asset_name", name)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 	if len(name) > 0 {
 		opts.QuerySet("_asset_name", name.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_history", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_history", nil, opts)
 	if err != nil {
 		return
 	}
 	info := []AssetHistory{}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &info)
-
-	if len(info) == 1 {
-		res.Data = &info[0]
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &info); err != nil {
+		return
 	}
+	res.Data, err = firstItem(info, "asset history %s.%s", policy, name)
 	return
 }
 
@@ -441,17 +743,22 @@ func (c *Client) GetPolicyAssetList(
 ) (res *AssetPolicyAssetListResponse, err error) {
 	res = &AssetPolicyAssetListResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/policy_asset_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/policy_asset_list", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -461,11 +768,11 @@ func (c *Client) GetAssetTokenRegistry(
 ) (res *AssetTokenRegistryResponse, err error) {
 	res = &AssetTokenRegistryResponse{}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_token_registry", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_token_registry", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -476,10 +783,15 @@ func (c *Client) GetAssetUTxOs(
 ) (res *AssetUTxOsResponse, err error) {
 	res = &AssetUTxOsResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.assets("_asset_list", assets)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
 
+	opts = c.requestOptions(opts)
+
 	var payload = struct {
 		Assets [][]string `json:"_asset_list"`
 	}{}
@@ -497,11 +809,11 @@ func (c *Client) GetAssetUTxOs(
 		defer w.Close()
 	}()
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/asset_utxos", rpipe, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/asset_utxos", rpipe, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -513,23 +825,32 @@ func (c *Client) GetAssetNftAddress(
 ) (res *AssetNFTAddressResponse, err error) {
 	res = &AssetNFTAddressResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+		v.value("_asset_name", name)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 	opts.QuerySet("_asset_name", name.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/asset_nft_address", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/asset_nft_address", nil, opts)
 	if err != nil {
 		return
 	}
 
 	var holders []AssetHolder
-
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &holders)
-	if len(holders) > 0 {
-		res.Data = &holders[0].PaymentAddress
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &holders); err != nil {
+		return
+	}
+	holder, err := firstItem(holders, "nft address %s.%s", policy, name)
+	if err != nil {
+		return
 	}
+	res.Data = &holder.PaymentAddress
 	return
 }
 
@@ -540,16 +861,21 @@ func (c *Client) GetPolicyAssetAddresses(
 ) (res *AssetAddressListResponse, err error) {
 	res = &AssetAddressListResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/policy_asset_addresses", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/policy_asset_addresses", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	return
 }
@@ -561,16 +887,21 @@ func (c *Client) GetPolicyAssetMints(
 ) (res *PolicyAssetMintsResponse, err error) {
 	res = &PolicyAssetMintsResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_asset_policy", policy)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_asset_policy", policy.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/policy_asset_mints", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/policy_asset_mints", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
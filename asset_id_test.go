@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// CIP-14 test vectors.
+func TestNewAssetFingerprint(t *testing.T) {
+	tests := []struct {
+		policy PolicyID
+		name   AssetName
+		want   AssetFingerprint
+	}{
+		{
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "",
+			"asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3",
+		},
+		{
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc37e", "",
+			"asset1nl0puwxmhas8fawxp8nx4e2q3wekg969n2auw3",
+		},
+		{
+			"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "",
+			"asset1uyuxku60yqe57nusqzjx38aan3f2wq6s93f6ea",
+		},
+		{
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "504154415445",
+			"asset13n25uv0yaf5kus35fm2k86cqy60z58d9xmde92",
+		},
+		{
+			"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "504154415445",
+			"asset1hv4p5tv2a837mzqrst04d0dcptdjmluqvdx9k3",
+		},
+		{
+			"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209",
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+			"asset1aqrdypg669jgazruv5ah07nuyqe0wxjhe2el6f",
+		},
+		{
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+			"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209",
+			"asset17jd78wukhtrnmjh3fngzasxm8rck0l2r4hhyyt",
+		},
+		{
+			"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want.String(), func(t *testing.T) {
+			fp, err := NewAssetFingerprint(tt.policy, tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fp != tt.want {
+				t.Errorf("expected %s got %s", tt.want, fp)
+			}
+			if _, err := fp.Valid(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestNewAssetFingerprintInvalid(t *testing.T) {
+	const policy = "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373"
+	tests := []struct {
+		name   string
+		policy PolicyID
+		asset  AssetName
+		err    error
+	}{
+		{"short policy", PolicyID(policy[:54]), "", ErrPolicyID},
+		{"policy not hex", PolicyID(policy[:54] + "zz"), "", ErrPolicyID},
+		{"name not hex", policy, "5041544154zz", ErrAsset},
+		{"name too long", policy, AssetName(policy + policy[:10]), ErrAsset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewAssetFingerprint(tt.policy, tt.asset); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestResolveAssetInvalidName(t *testing.T) {
+	c, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.resolveAsset(context.Background(), Asset{
+		PolicyID:  "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373",
+		AssetName: "not hex",
+	})
+	if !errors.Is(err, ErrAsset) {
+		t.Errorf("expected %v got %v", ErrAsset, err)
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// CIP-67 label prefixes.
+func TestAssetLabelPrefix(t *testing.T) {
+	tests := []struct {
+		label  AssetLabel
+		prefix string
+	}{
+		{0, "00000000"},
+		{1, "00001070"},
+		{23, "00017650"},
+		{99, "000632e0"},
+		{AssetLabelReference, "000643b0"},
+		{AssetLabelNFT, "000de140"},
+		{AssetLabelFT, "0014df10"},
+		{AssetLabelRFT, "001bc280"},
+		{500, "001f4d70"},
+		{2000, "007d0550"},
+		{65535, "0ffff240"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label.String(), func(t *testing.T) {
+			if got := tt.label.Prefix(); got != tt.prefix {
+				t.Errorf("expected %s got %s", tt.prefix, got)
+			}
+			name := AssetName(tt.prefix + "74657374")
+			label, ok := name.Label()
+			if !ok || label != tt.label {
+				t.Errorf("expected label %d of %s got %d (%t)", tt.label, name, label, ok)
+			}
+			if base := name.BaseName(); base != "74657374" {
+				t.Errorf("expected base name 74657374 got %s", base)
+			}
+		})
+	}
+	if _, ok := AssetName("000de1f074657374").Label(); ok {
+		t.Error("expected invalid checksum to be rejected")
+	}
+}
+
+func TestLabeledAssetName(t *testing.T) {
+	// "SpaceBud" in hex.
+	const base = AssetName("5370616365427564")
+	tests := []struct {
+		label AssetLabel
+		want  AssetName
+	}{
+		{AssetLabelReference, "000643b05370616365427564"},
+		{AssetLabelNFT, "000de1405370616365427564"},
+		{AssetLabelFT, "0014df105370616365427564"},
+		{AssetLabelRFT, "001bc2805370616365427564"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.label.String(), func(t *testing.T) {
+			name, err := NewLabeledAssetName(tt.label, base)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != tt.want {
+				t.Fatalf("expected %s got %s", tt.want, name)
+			}
+			if got := name.DisplayName(); got != "SpaceBud" {
+				t.Errorf("expected display name SpaceBud got %s", got)
+			}
+			if s, ok := name.UTF8(); ok {
+				t.Errorf("expected labeled name not to be valid UTF-8 text got %q", s)
+			}
+			// relabeling replaces existing label.
+			ref, err := name.WithLabel(AssetLabelReference)
+			if err != nil || ref != "000643b05370616365427564" {
+				t.Errorf("expected reference name got %s (%v)", ref, err)
+			}
+			if ref, err = name.ReferenceName(); err != nil || ref != "000643b05370616365427564" {
+				t.Errorf("expected reference name got %s (%v)", ref, err)
+			}
+		})
+	}
+
+	if name, _ := NewLabeledAssetName(AssetLabelNFT, AssetName(strings.ToUpper(base.String()))); name != "000de1405370616365427564" {
+		t.Errorf("expected lower case name got %s", name)
+	}
+	if _, err := NewLabeledAssetName(AssetLabelNFT, AssetName(strings.Repeat("00", 29))); !errors.Is(err, ErrAsset) {
+		t.Errorf("expected ErrAsset for too long base name got %v", err)
+	}
+	if _, err := NewLabeledAssetName(AssetLabelNFT, "zz"); !errors.Is(err, ErrAsset) {
+		t.Errorf("expected ErrAsset for invalid hex got %v", err)
+	}
+	if _, err := base.ReferenceName(); !errors.Is(err, ErrAsset) {
+		t.Errorf("expected ErrAsset for unlabeled name got %v", err)
+	}
+}
+
+func TestAssetNameLabelInvalid(t *testing.T) {
+	tests := map[string]AssetName{
+		"bad crc":          "000de1f074657374",
+		"leading padding":  "100de14074657374",
+		"trailing padding": "000de14174657374",
+		"short":            "000de1",
+		"invalid hex":      "000de14z",
+	}
+	for name, v := range tests {
+		t.Run(name, func(t *testing.T) {
+			if label, ok := v.Label(); ok {
+				t.Errorf("expected no label got %d", label)
+			}
+			if base := v.BaseName(); base != v {
+				t.Errorf("expected base name %s got %s", v, base)
+			}
+		})
+	}
+}
+
+func TestAssetNameDisplay(t *testing.T) {
+	tests := []struct {
+		name    AssetName
+		utf8    string
+		utf8OK  bool
+		display string
+	}{
+		{name: "74657374", utf8: "test", utf8OK: true, display: "test"},
+		{name: "", utf8: "", utf8OK: true, display: ""},
+		{name: "e282ac", utf8: "€", utf8OK: true, display: "€"},
+		{name: "ff00aa", display: "ff00aa"},
+		{name: "0074657374", display: "0074657374"},
+		{name: "000de140ff00aa", display: "ff00aa"},
+		{name: "zz", display: "zz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name.String(), func(t *testing.T) {
+			s, ok := tt.name.UTF8()
+			if s != tt.utf8 || ok != tt.utf8OK {
+				t.Errorf("UTF8: expected %q (%t) got %q (%t)", tt.utf8, tt.utf8OK, s, ok)
+			}
+			if got := tt.name.DisplayName(); got != tt.display {
+				t.Errorf("DisplayName: expected %s got %s", tt.display, got)
+			}
+		})
+	}
+}
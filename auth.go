@@ -16,26 +16,22 @@ import (
 func GetTokenAuthInfo(jwt string) (AuthInfo, error) {
 	auth, err := decodeJWT(jwt)
 	if err != nil {
-		fmt.Printf("Error decoding JWT: %v\n", err)
 		return AuthInfo{}, fmt.Errorf("%w: error decoding JWT %v", ErrAuth, err)
 	}
 	return *auth, nil
 }
 
+// SetAuth sets Koios JWT auth token used for requests.
+// It is safe to call while client is in use, see Client.Reconfigure.
 func (c *Client) SetAuth(jwt string) error {
-	auth, err := GetTokenAuthInfo(jwt)
-	if err != nil {
-		return err
-	}
-	c.auth = &auth
-	return nil
+	return c.Reconfigure(Auth(jwt))
 }
 
-func (c *Client) getAuth() AuthInfo {
-	if c.auth == nil {
+func (cfg *config) getAuth() AuthInfo {
+	if cfg.auth == nil {
 		return AuthInfo{}
 	}
-	auth := *c.auth
+	auth := *cfg.auth
 	return auth
 }
 
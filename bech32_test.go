@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestBech32RoundTrip(t *testing.T) {
+	tests := []struct {
+		hrp string
+		hex string
+		enc string
+	}{
+		{"addr", "61" + cip19PaymentKeyHash, "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"},
+		{"stake", "e1" + cip19StakeKeyHash, "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		// BIP-173 test vectors.
+		{"a", "", "a12uel5l"},
+		{"abcdef", "00443214c74254b635cf84653a56d7c675be77df", "abcdef1qpzry9x8gf2tvdw0s3jn54khce6mua7lmqqqxw"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.enc, func(t *testing.T) {
+			enc, err := bech32Encode(tt.hrp, mustHex(t, tt.hex))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if enc != tt.enc {
+				t.Errorf("encode: expected %s got %s", tt.enc, enc)
+			}
+			hrp, b, err := bech32Decode(tt.enc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hrp != tt.hrp || !bytes.Equal(b, mustHex(t, tt.hex)) {
+				t.Errorf("decode: expected %s %s got %s %x", tt.hrp, tt.hex, hrp, b)
+			}
+		})
+	}
+}
+
+func TestBech32DecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{"bad checksum", "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgq"},
+		{"wrong hrp", "stake_test1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"mixed case", "Stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"invalid character", "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffbw"},
+		{"no separator", "stakeuyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw"},
+		{"empty hrp", "1qqqqqqqq"},
+		{"short checksum", "pool1qqqq"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := bech32Decode(tt.s); !errors.Is(err, errBech32) {
+				t.Errorf("expected %v got %v", errBech32, err)
+			}
+		})
+	}
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
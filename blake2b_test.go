@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBlake2bSum(t *testing.T) {
+	long := bytes.Repeat(func() []byte {
+		b := make([]byte, 256)
+		for i := range b {
+			b[i] = byte(i)
+		}
+		return b
+	}(), 2)
+
+	tests := []struct {
+		name string
+		data []byte
+		size int
+		want string
+	}{
+		{"224 empty", nil, 28, "836cc68931c2e4e3e838602eca1902591d216837bafddfe6f0c8cb07"},
+		{"224 abc", []byte("abc"), 28, "9bd237b02a29e43bdd6738afa5b53ff0eee178d6210b618e4511aec8"},
+		{"224 multi block", long, 28, "8d853f3e3353feaaad5c72506e51c194679c561bf8bfde0a8a2b16a6"},
+		{"256 empty", nil, 32, "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8"},
+		{"256 abc", []byte("abc"), 32, "bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319"},
+		{"256 multi block", long, 32, "540b20132d8aeae54057cb69c24f95d26a1c472cc700dd450defe9bb796d4f14"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hex.EncodeToString(blake2bSum(tt.data, tt.size)); got != tt.want {
+				t.Errorf("expected %s got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ package koios
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 
 	"github.com/shopspring/decimal"
@@ -74,20 +73,11 @@ type (
 	}
 
 	// BlocksResponse represents response from `/blocks` endpoint.
-	BlocksResponse struct {
-		Response
-		Data []Block `json:"data"`
-	}
+	BlocksResponse = ListResponse[Block]
 	// BlockInfoResponse represents response from `/block_info` endpoint.
-	BlockInfoResponse struct {
-		Response
-		Data Block `json:"data"`
-	}
+	BlockInfoResponse = Response[*Block]
 	// BlockInfoResponse represents response from `/block_info` endpoint.
-	BlocksInfoResponse struct {
-		Response
-		Data []Block `json:"data"`
-	}
+	BlocksInfoResponse = ListResponse[Block]
 	// BlockTxsHashesResponse represents response from `/block_txs` endpoint.
 
 	BlockTxs struct {
@@ -98,14 +88,13 @@ type (
 		BlockTime   Timestamp `json:"block_time"`
 	}
 
-	BlocksTxsResponse struct {
-		Response
-		Data []BlockTxs `json:"data"`
-	}
-	BlockTxsResponse struct {
-		Response
-		Data BlockTxs `json:"data"`
-	}
+	BlocksTxsResponse = ListResponse[BlockTxs]
+	BlockTxsResponse  = Response[*BlockTxs]
+
+	// BlockTxsInfoResponse represents response from `/block_tx_info` endpoint.
+	BlockTxsInfoResponse = ListResponse[TX]
+	// BlockTxsCBORResponse represents response from `/block_tx_cbor` endpoint.
+	BlockTxsCBORResponse = ListResponse[TxCBOR]
 )
 
 // GetBlocks returns summarised details about all blocks (paginated - latest first).
@@ -114,11 +103,11 @@ func (c *Client) GetBlocks(
 	opts *RequestOptions,
 ) (res *BlocksResponse, err error) {
 	res = &BlocksResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/blocks", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/blocks", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -130,13 +119,11 @@ func (c *Client) GetBlockInfo(
 ) (res *BlockInfoResponse, err error) {
 	res = &BlockInfoResponse{}
 	rsp, err := c.GetBlockInfos(ctx, []BlockHash{hash}, opts)
-	res.Response = rsp.Response
-
-	if len(rsp.Data) > 0 {
-		res.Data = rsp.Data[0]
-	} else {
-		err = fmt.Errorf("%w: block_info response was empty", ErrResponse)
+	res.ResponseMeta = rsp.ResponseMeta
+	if err != nil {
+		return
 	}
+	res.Data, err = firstItem(rsp.Data, "block %s", hash)
 	return
 }
 
@@ -147,12 +134,20 @@ func (c *Client) GetBlockInfos(
 	opts *RequestOptions,
 ) (res *BlocksInfoResponse, err error) {
 	res = &BlocksInfoResponse{}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/block_info", blockHashesPL(hashes), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_block_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/block_info", blockHashesPL(hashes), opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -164,13 +159,11 @@ func (c *Client) GetBlockTxs(
 ) (res *BlockTxsResponse, err error) {
 	res = &BlockTxsResponse{}
 	rsp, err := c.GetBlocksTxs(ctx, []BlockHash{hash}, opts)
-	res.Response = rsp.Response
-
-	if len(rsp.Data) > 0 {
-		res.Data = rsp.Data[0]
-	} else {
-		err = fmt.Errorf("%w: block %s had no transactions", ErrNoData, hash)
+	res.ResponseMeta = rsp.ResponseMeta
+	if err != nil {
+		return
 	}
+	res.Data, err = firstItem(rsp.Data, "block %s had no transactions", hash)
 	return
 }
 
@@ -181,14 +174,108 @@ func (c *Client) GetBlocksTxs(
 	opts *RequestOptions,
 ) (res *BlocksTxsResponse, err error) {
 	res = &BlocksTxsResponse{}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/block_txs", blockHashesPL(hashes), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_block_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/block_txs", blockHashesPL(hashes), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
+// GetBlockTxInfo returns detailed information about all transactions
+// included in given blocks. Flags control which details are included.
+func (c *Client) GetBlockTxInfo(
+	ctx context.Context,
+	hashes []BlockHash,
+	flags TxInfoFlags,
+	opts *RequestOptions,
+) (res *BlockTxsInfoResponse, err error) {
+	res = &BlockTxsInfoResponse{}
+	if len(hashes) == 0 {
+		err = ErrNoBlockHash
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_block_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/block_tx_info", blockTxInfoPL(hashes, flags), opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetBlockTxCBOR returns raw CBOR of all transactions included in given blocks.
+func (c *Client) GetBlockTxCBOR(
+	ctx context.Context,
+	hashes []BlockHash,
+	opts *RequestOptions,
+) (res *BlockTxsCBORResponse, err error) {
+	res = &BlockTxsCBORResponse{}
+	if len(hashes) == 0 {
+		err = ErrNoBlockHash
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_block_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/block_tx_cbor", blockHashesPL(hashes), opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+func blockTxInfoPL(bhash []BlockHash, flags TxInfoFlags) io.Reader {
+	var payload = struct {
+		BlockHashes []BlockHash `json:"_block_hashes"`
+		Inputs      bool        `json:"_inputs"`
+		Metadata    bool        `json:"_metadata"`
+		Assets      bool        `json:"_assets"`
+		Withdrawals bool        `json:"_withdrawals"`
+		Certs       bool        `json:"_certs"`
+		Scripts     bool        `json:"_scripts"`
+		Bytecode    bool        `json:"_bytecode"`
+	}{
+		bhash,
+		flags.Inputs,
+		flags.Metadata,
+		flags.Assets,
+		flags.Withdrawals,
+		flags.Certs,
+		flags.Scripts,
+		flags.Bytecode,
+	}
+	rpipe, w := io.Pipe()
+	go func() {
+		_ = json.NewEncoder(w).Encode(payload)
+		defer w.Close()
+	}()
+	return rpipe
+}
+
 func blockHashesPL(bhash []BlockHash) io.Reader {
 	var payload = struct {
 		BlockHashes []BlockHash `json:"_block_hashes"`
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/big"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var errBase58 = errors.New("base58")
+
+// base58Decode decodes bitcoin alphabet base58 string used by Byron addresses.
+func base58Decode(s string) ([]byte, error) {
+	if len(s) == 0 {
+		return nil, fmt.Errorf("%w: empty input", errBase58)
+	}
+	var (
+		n    = new(big.Int)
+		base = big.NewInt(58)
+		zero = 0
+	)
+	for i := 0; i < len(s) && s[i] == base58Alphabet[0]; i++ {
+		zero++
+	}
+	for i := 0; i < len(s); i++ {
+		d := -1
+		for j := 0; j < len(base58Alphabet); j++ {
+			if base58Alphabet[j] == s[i] {
+				d = j
+				break
+			}
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("%w: invalid character %q", errBase58, s[i])
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(d)))
+	}
+	return append(make([]byte, zero), n.Bytes()...), nil
+}
+
+// byronAddress is decoded Byron era bootstrap address.
+type byronAddress struct {
+	root          []byte
+	addrType      uint64
+	protocolMagic *uint32
+}
+
+// decodeByronAddress decodes base58 encoded Byron address and verifies its checksum.
+func decodeByronAddress(s string) (*byronAddress, error) {
+	raw, err := base58Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	r := newCBORReader(raw)
+	if n, err := r.arrayLen(); err != nil || n != 2 {
+		return nil, fmt.Errorf("%w: byron address must be array of 2 items", errCBOR)
+	}
+	if tag, err := r.tag(); err != nil || tag != 24 {
+		return nil, fmt.Errorf("%w: byron address payload must be tagged with 24", errCBOR)
+	}
+	payload, err := r.bytes()
+	if err != nil {
+		return nil, err
+	}
+	crc, err := r.uint()
+	if err != nil {
+		return nil, err
+	}
+	if !r.done() {
+		return nil, fmt.Errorf("%w: trailing bytes", errCBOR)
+	}
+	if uint64(crc32.ChecksumIEEE(payload)) != crc {
+		return nil, fmt.Errorf("%w: invalid byron address checksum", ErrAddress)
+	}
+
+	addr := &byronAddress{}
+	p := newCBORReader(payload)
+	if n, err := p.arrayLen(); err != nil || n != 3 {
+		return nil, fmt.Errorf("%w: byron address payload must be array of 3 items", errCBOR)
+	}
+	if addr.root, err = p.bytes(); err != nil {
+		return nil, err
+	}
+	if len(addr.root) != 28 {
+		return nil, fmt.Errorf("%w: invalid byron address root length %d", ErrAddress, len(addr.root))
+	}
+	attrs, err := p.mapLen()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < attrs; i++ {
+		key, err := p.uint()
+		if err != nil {
+			return nil, err
+		}
+		if key != 2 {
+			if err := p.skip(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// network magic is cbor encoded uint32 wrapped in bytes.
+		b, err := p.bytes()
+		if err != nil {
+			return nil, err
+		}
+		magic, err := newCBORReader(b).uint()
+		if err != nil {
+			return nil, err
+		}
+		if magic > math.MaxUint32 {
+			return nil, fmt.Errorf("%w: byron address protocol magic %d out of range", ErrAddress, magic)
+		}
+		m := uint32(magic)
+		addr.protocolMagic = &m
+	}
+	if addr.addrType, err = p.uint(); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// EpochCalendar computes epoch boundaries, stake snapshot and reward
+	// schedule from genesis parameters. Only Current makes requests.
+	EpochCalendar struct {
+		*TimeConverter
+		client *Client
+		// stabilityWindow is 3k/f slots.
+		stabilityWindow uint64
+		// randomnessWindow is 4k/f slots.
+		randomnessWindow uint64
+	}
+
+	// EpochSchedule holds boundaries of single epoch.
+	EpochSchedule struct {
+		EpochNo EpochNo `json:"epoch_no"`
+		// StartSlot is first absolute slot of epoch.
+		StartSlot Slot `json:"start_slot"`
+		// EndSlot is first absolute slot of next epoch.
+		EndSlot Slot      `json:"end_slot"`
+		Start   time.Time `json:"start"`
+		End     time.Time `json:"end"`
+		// StabilitySlot is end of stability window (3k/f slots)
+		// after epoch start when previous epoch snapshot is final.
+		StabilitySlot Slot      `json:"stability_slot"`
+		StabilityTime time.Time `json:"stability_time"`
+		// RandomnessFreezeSlot is 4k/f slots before end of epoch when
+		// nonce used for leader election of next epoch is frozen.
+		RandomnessFreezeSlot Slot      `json:"randomness_freeze_slot"`
+		RandomnessFreezeTime time.Time `json:"randomness_freeze_time"`
+		// Stake snapshot taken at end of the epoch is "mark" snapshot
+		// in MarkEpoch, "set" snapshot used for leader election in SetEpoch
+		// and "go" snapshot used for reward calculation in GoEpoch.
+		MarkEpoch EpochNo `json:"mark_epoch"`
+		SetEpoch  EpochNo `json:"set_epoch"`
+		GoEpoch   EpochNo `json:"go_epoch"`
+		// RewardEpoch is epoch at which start rewards earned
+		// in the epoch are distributed.
+		RewardEpoch EpochNo   `json:"reward_epoch"`
+		RewardTime  time.Time `json:"reward_time"`
+	}
+
+	// EpochProgress is progress of epoch at given slot.
+	EpochProgress struct {
+		EpochSchedule
+		// Slot is absolute slot progress was computed for.
+		Slot      Slot `json:"slot"`
+		EpochSlot Slot `json:"epoch_slot"`
+		// Progress of epoch in percent.
+		Progress  decimal.Decimal `json:"progress"`
+		Elapsed   time.Duration   `json:"elapsed"`
+		Remaining time.Duration   `json:"remaining"`
+		// Tip and Info are set by EpochCalendar.Current.
+		Tip  *Tip       `json:"tip,omitempty"`
+		Info *EpochInfo `json:"info,omitempty"`
+	}
+)
+
+// EpochCalendar returns EpochCalendar for network of the client.
+func (c *Client) EpochCalendar(ctx context.Context, opts *RequestOptions) (*EpochCalendar, error) {
+	res, err := c.GetGenesis(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	cal, err := NewEpochCalendar(res.Data)
+	if err != nil {
+		return nil, err
+	}
+	cal.client = c
+	return cal, nil
+}
+
+// NewEpochCalendar returns EpochCalendar for network described by genesis.
+func NewEpochCalendar(g *Genesis) (*EpochCalendar, error) {
+	tc, err := NewTimeConverter(g)
+	if err != nil {
+		return nil, err
+	}
+	if !g.ActiveSlotCoeff.IsPositive() || !g.SecurityParam.IsPositive() {
+		return nil, fmt.Errorf("%w: security param and active slot coefficient are required", ErrTimeConverter)
+	}
+	window := func(n int64) uint64 {
+		return uint64(g.SecurityParam.Mul(decimal.NewFromInt(n)).Div(g.ActiveSlotCoeff).Ceil().IntPart())
+	}
+	return &EpochCalendar{
+		TimeConverter:    tc,
+		stabilityWindow:  window(3),
+		randomnessWindow: window(4),
+	}, nil
+}
+
+// StabilityWindow returns number of slots in stability window (3k/f).
+func (ec *EpochCalendar) StabilityWindow() uint64 {
+	return ec.stabilityWindow
+}
+
+// Schedule returns boundaries of epoch.
+func (ec *EpochCalendar) Schedule(epoch EpochNo) EpochSchedule {
+	s := EpochSchedule{
+		EpochNo:     epoch,
+		StartSlot:   ec.EpochStartSlot(epoch),
+		EndSlot:     ec.EpochStartSlot(epoch + 1),
+		MarkEpoch:   epoch + 1,
+		SetEpoch:    epoch + 2,
+		GoEpoch:     epoch + 3,
+		RewardEpoch: epoch + 2,
+	}
+	length := uint64(s.EndSlot - s.StartSlot)
+	s.StabilitySlot = s.StartSlot + Slot(min(ec.stabilityWindow, length))
+	s.RandomnessFreezeSlot = s.EndSlot - Slot(min(ec.randomnessWindow, length))
+	s.Start = ec.SlotToTime(s.StartSlot)
+	s.End = ec.SlotToTime(s.EndSlot)
+	s.StabilityTime = ec.SlotToTime(s.StabilitySlot)
+	s.RandomnessFreezeTime = ec.SlotToTime(s.RandomnessFreezeSlot)
+	s.RewardTime = ec.EpochStart(s.RewardEpoch)
+	return s
+}
+
+// ProgressAt returns progress of epoch containing time t.
+func (ec *EpochCalendar) ProgressAt(t time.Time) (EpochProgress, error) {
+	slot, err := ec.TimeToSlot(t)
+	if err != nil {
+		return EpochProgress{}, err
+	}
+	p := ec.progress(slot)
+	p.Elapsed = t.Sub(p.Start)
+	p.Remaining = p.End.Sub(t)
+	return p, nil
+}
+
+// Current returns progress of current epoch based on chain tip
+// including epoch info of the current epoch.
+func (ec *EpochCalendar) Current(ctx context.Context, opts *RequestOptions) (*EpochProgress, error) {
+	if ec.client == nil {
+		return nil, fmt.Errorf("%w: calendar is not bound to client", ErrTimeConverter)
+	}
+	tip, err := ec.client.GetTip(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ec.ProgressAt(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if p.EpochNo != tip.Data.EpochNo {
+		// tip is behind wall clock e.g. during epoch transition.
+		p = ec.progress(tip.Data.AbsSlot)
+	}
+	p.Tip = tip.Data
+
+	info, err := ec.client.GetEpochInfo(ctx, tip.Data.EpochNo, false, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Data) > 0 {
+		p.Info = &info.Data[0]
+	}
+	return &p, nil
+}
+
+func (ec *EpochCalendar) progress(slot Slot) EpochProgress {
+	epoch, epochSlot := ec.SlotToEpoch(slot)
+	p := EpochProgress{
+		EpochSchedule: ec.Schedule(epoch),
+		Slot:          slot,
+		EpochSlot:     epochSlot,
+	}
+	length := int64(p.EndSlot - p.StartSlot)
+	p.Progress = decimal.NewFromInt(int64(epochSlot)).
+		Mul(decimal.NewFromInt(100)).
+		Div(decimal.NewFromInt(length)).
+		Round(2)
+	t := ec.SlotToTime(slot)
+	p.Elapsed = t.Sub(p.Start)
+	p.Remaining = p.End.Sub(t)
+	return p
+}
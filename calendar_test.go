@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestEpochCalendarSchedule(t *testing.T) {
+	cal, err := NewEpochCalendar(&Genesis{
+		NetworkMagic:    decimal.NewFromInt(int64(Mainnet.Magic)),
+		SystemStart:     Timestamp{Mainnet.SystemStart},
+		SlotLength:      decimal.NewFromInt(1),
+		EpochLength:     decimal.NewFromInt(432000),
+		SecurityParam:   decimal.NewFromInt(2160),
+		ActiveSlotCoeff: decimal.RequireFromString("0.05"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.StabilityWindow(); got != 129600 {
+		t.Errorf("expected stability window 129600 got %d", got)
+	}
+
+	date := func(d, h int) time.Time {
+		return time.Date(2024, 7, d, h, 44, 51, 0, time.UTC)
+	}
+	// mainnet epoch 500 started 2024-07-28T21:44:51Z at slot 130636800.
+	want := EpochSchedule{
+		EpochNo:              500,
+		StartSlot:            130636800,
+		EndSlot:              131068800,
+		Start:                date(28, 21),
+		End:                  time.Date(2024, 8, 2, 21, 44, 51, 0, time.UTC),
+		StabilitySlot:        130766400,
+		StabilityTime:        date(30, 9),
+		RandomnessFreezeSlot: 130896000,
+		RandomnessFreezeTime: date(31, 21),
+		MarkEpoch:            501,
+		SetEpoch:             502,
+		GoEpoch:              503,
+		RewardEpoch:          502,
+		RewardTime:           time.Date(2024, 8, 7, 21, 44, 51, 0, time.UTC),
+	}
+	if got := cal.Schedule(500); got != want {
+		t.Errorf("expected\n%+v\ngot\n%+v", want, got)
+	}
+
+	// Byron epoch is shorter than stability window.
+	byron := cal.Schedule(207)
+	if byron.StabilitySlot != byron.EndSlot || byron.RandomnessFreezeSlot != byron.StartSlot {
+		t.Errorf("windows are not limited to Byron epoch length: %+v", byron)
+	}
+
+	p, err := cal.ProgressAt(date(29, 21))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.EpochNo != 500 || p.EpochSlot != 86400 || !p.Progress.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("unexpected progress %s of epoch %d slot %d", p.Progress, p.EpochNo, p.EpochSlot)
+	}
+	if p.Elapsed != 24*time.Hour || p.Remaining != 96*time.Hour {
+		t.Errorf("unexpected elapsed %s remaining %s", p.Elapsed, p.Remaining)
+	}
+
+	if _, err := NewEpochCalendar(&Genesis{
+		SystemStart: Timestamp{Mainnet.SystemStart},
+		SlotLength:  decimal.NewFromInt(1),
+		EpochLength: decimal.NewFromInt(432000),
+	}); !errors.Is(err, ErrTimeConverter) {
+		t.Errorf("expected ErrTimeConverter without security param got %v", err)
+	}
+}
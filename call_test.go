@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid payload"}`))
+			return
+		}
+		// echo request back to the caller.
+		_ = json.NewEncoder(w).Encode([]map[string]string{{
+			"method":       r.Method,
+			"path":         r.URL.Path,
+			"content_type": r.Header.Get("Content-Type"),
+			"body":         string(body),
+		}})
+	}))
+	defer srv.Close()
+
+	c, err := New(BaseURL(srv.URL + "/api/v1/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		payload any
+		body    string
+	}{
+		{name: "nil", method: "GET", payload: nil, body: ""},
+		{name: "reader", method: "POST", payload: strings.NewReader(`{"a":1}`), body: `{"a":1}`},
+		{name: "bytes", method: "POST", payload: []byte(`{"b":2}`), body: `{"b":2}`},
+		{name: "string", method: "POST", payload: `{"c":3}`, body: `{"c":3}`},
+		{name: "json", method: "POST", payload: map[string][]string{"_tx_hashes": {validTxHash}}, body: `{"_tx_hashes":["` + validTxHash + `"]}`},
+		{name: "json struct", method: "post", payload: struct {
+			Epoch EpochNo `json:"_epoch_no"`
+		}{500}, body: `{"_epoch_no":500}`},
+	}
+	type echo struct {
+		Method      string `json:"method"`
+		Path        string `json:"path"`
+		ContentType string `json:"content_type"`
+		Body        string `json:"body"`
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Call[[]echo](context.Background(), c, tt.method, "/echo", tt.payload, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Data) != 1 {
+				t.Fatalf("expected single decoded item got %v", res.Data)
+			}
+			got := res.Data[0]
+			if got.Method != strings.ToUpper(tt.method) || got.Path != "/api/v1/echo" || got.Body != tt.body {
+				t.Errorf("unexpected request %+v", got)
+			}
+			if got.Method == "POST" && got.ContentType != "application/json" {
+				t.Errorf("expected json content type got %q", got.ContentType)
+			}
+			if res.RequestMethod != got.Method || res.StatusCode != http.StatusOK {
+				t.Errorf("unexpected response meta %s %d", res.RequestMethod, res.StatusCode)
+			}
+		})
+	}
+
+	t.Run("decode into other type", func(t *testing.T) {
+		res, err := Call[[]map[string]string](context.Background(), c, "GET", "echo", nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Data) != 1 || res.Data[0]["path"] != "/api/v1/echo" {
+			t.Errorf("unexpected data %v", res.Data)
+		}
+	})
+
+	t.Run("unmarshalable payload", func(t *testing.T) {
+		res, err := Call[[]echo](context.Background(), c, "POST", "/echo", func() {}, nil)
+		if err == nil || res.Error == nil {
+			t.Fatalf("expected encoding error got %v", err)
+		}
+		if res.RequestMethod != "POST" {
+			t.Errorf("expected request method to be set got %q", res.RequestMethod)
+		}
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		res, err := Call[[]echo](context.Background(), c, "POST", "/fail", `{}`, nil)
+		if !errors.Is(err, ErrResponse) {
+			t.Fatalf("expected ErrResponse got %v", err)
+		}
+		if res.Error == nil || !strings.Contains(res.Error.Message, "invalid payload") {
+			t.Errorf("expected error message from response body got %v", res.Error)
+		}
+	})
+}
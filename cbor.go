@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Minimal CBOR (RFC 8949) reader and writer which is sufficient
+// to decode addresses and to encode and decode metadata.
+
+const (
+	cborUint   byte = 0
+	cborNegInt byte = 1
+	cborBytes  byte = 2
+	cborText   byte = 3
+	cborArray  byte = 4
+	cborMap    byte = 5
+	cborTag    byte = 6
+	cborSimple byte = 7
+)
+
+var errCBOR = errors.New("cbor")
+
+type cborReader struct {
+	b   []byte
+	off int
+}
+
+func newCBORReader(b []byte) *cborReader {
+	return &cborReader{b: b}
+}
+
+// done reports whether all input was consumed.
+func (r *cborReader) done() bool {
+	return r.off >= len(r.b)
+}
+
+// peek returns major type of next item without consuming it.
+func (r *cborReader) peek() (byte, error) {
+	if r.done() {
+		return 0, fmt.Errorf("%w: unexpected end of input", errCBOR)
+	}
+	return r.b[r.off] >> 5, nil
+}
+
+// head reads major type and argument of next item.
+func (r *cborReader) head() (major byte, arg uint64, err error) {
+	if r.done() {
+		return 0, 0, fmt.Errorf("%w: unexpected end of input", errCBOR)
+	}
+	ib := r.b[r.off]
+	r.off++
+	major = ib >> 5
+	info := ib & 0x1f
+	var n int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		n = 1
+	case info == 25:
+		n = 2
+	case info == 26:
+		n = 4
+	case info == 27:
+		n = 8
+	default:
+		return 0, 0, fmt.Errorf("%w: unsupported additional info %d", errCBOR, info)
+	}
+	if r.off+n > len(r.b) {
+		return 0, 0, fmt.Errorf("%w: unexpected end of input", errCBOR)
+	}
+	for _, c := range r.b[r.off : r.off+n] {
+		arg = arg<<8 | uint64(c)
+	}
+	r.off += n
+	return major, arg, nil
+}
+
+func (r *cborReader) expect(major byte) (uint64, error) {
+	m, arg, err := r.head()
+	if err != nil {
+		return 0, err
+	}
+	if m != major {
+		return 0, fmt.Errorf("%w: expected major type %d got %d", errCBOR, major, m)
+	}
+	return arg, nil
+}
+
+func (r *cborReader) uint() (uint64, error) {
+	return r.expect(cborUint)
+}
+
+func (r *cborReader) bytes() ([]byte, error) {
+	return r.bytesOf(cborBytes)
+}
+
+func (r *cborReader) text() (string, error) {
+	b, err := r.bytesOf(cborText)
+	return string(b), err
+}
+
+func (r *cborReader) bytesOf(major byte) ([]byte, error) {
+	n, err := r.expect(major)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.b)-r.off) {
+		return nil, fmt.Errorf("%w: unexpected end of input", errCBOR)
+	}
+	b := r.b[r.off : r.off+int(n)]
+	r.off += int(n)
+	return b, nil
+}
+
+func (r *cborReader) arrayLen() (int, error) {
+	n, err := r.expect(cborArray)
+	return int(n), err
+}
+
+func (r *cborReader) mapLen() (int, error) {
+	n, err := r.expect(cborMap)
+	return int(n), err
+}
+
+func (r *cborReader) tag() (uint64, error) {
+	return r.expect(cborTag)
+}
+
+// indefinite consumes head of indefinite length item of given
+// major type and reports whether it was next.
+func (r *cborReader) indefinite(major byte) bool {
+	if !r.done() && r.b[r.off] == major<<5|31 {
+		r.off++
+		return true
+	}
+	return false
+}
+
+// breakCode consumes break stop code and reports whether it was next.
+func (r *cborReader) breakCode() bool {
+	if !r.done() && r.b[r.off] == 0xff {
+		r.off++
+		return true
+	}
+	return false
+}
+
+// skip skips next data item including nested items.
+func (r *cborReader) skip() error {
+	m, arg, err := r.head()
+	if err != nil {
+		return err
+	}
+	switch m {
+	case cborBytes, cborText:
+		if arg > uint64(len(r.b)-r.off) {
+			return fmt.Errorf("%w: unexpected end of input", errCBOR)
+		}
+		r.off += int(arg)
+	case cborArray:
+		for i := uint64(0); i < arg; i++ {
+			if err := r.skip(); err != nil {
+				return err
+			}
+		}
+	case cborMap:
+		for i := uint64(0); i < arg*2; i++ {
+			if err := r.skip(); err != nil {
+				return err
+			}
+		}
+	case cborTag:
+		return r.skip()
+	}
+	return nil
+}
+
+// cborWriter writes definite length CBOR items.
+type cborWriter struct {
+	b []byte
+}
+
+// head writes major type and argument using shortest encoding.
+func (w *cborWriter) head(major byte, arg uint64) {
+	major <<= 5
+	switch {
+	case arg < 24:
+		w.b = append(w.b, major|byte(arg))
+	case arg <= 0xff:
+		w.b = append(w.b, major|24, byte(arg))
+	case arg <= 0xffff:
+		w.b = append(w.b, major|25, byte(arg>>8), byte(arg))
+	case arg <= 0xffffffff:
+		w.b = append(w.b, major|26, byte(arg>>24), byte(arg>>16), byte(arg>>8), byte(arg))
+	default:
+		w.b = append(w.b, major|27)
+		for i := 56; i >= 0; i -= 8 {
+			w.b = append(w.b, byte(arg>>uint(i)))
+		}
+	}
+}
+
+func (w *cborWriter) bytes(b []byte) {
+	w.head(cborBytes, uint64(len(b)))
+	w.b = append(w.b, b...)
+}
+
+func (w *cborWriter) text(s string) {
+	w.head(cborText, uint64(len(s)))
+	w.b = append(w.b, s...)
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetadataLabelNFT is transaction metadata label of CIP-25 NFT metadata.
+const MetadataLabelNFT = "721"
+
+type (
+	// NFTMetadata is CIP-25 metadata of single asset.
+	// See https://cips.cardano.org/cip/CIP-0025
+	NFTMetadata struct {
+		PolicyID    PolicyID  `json:"policy_id"`
+		AssetName   AssetName `json:"asset_name"`
+		Version     int       `json:"version"`
+		Name        string    `json:"name"`
+		Image       string    `json:"image"`
+		MediaType   string    `json:"mediaType,omitempty"`
+		Description string    `json:"description,omitempty"`
+		Files       []NFTFile `json:"files,omitempty"`
+		// Other properties of the asset not defined by CIP-25.
+		Other map[string]json.RawMessage `json:"other,omitempty"`
+	}
+
+	// NFTFile is entry of CIP-25 metadata files.
+	NFTFile struct {
+		Name      string                     `json:"name,omitempty"`
+		MediaType string                     `json:"mediaType"`
+		Src       string                     `json:"src"`
+		Other     map[string]json.RawMessage `json:"other,omitempty"`
+	}
+)
+
+// CIP25 returns CIP-25 metadata of the asset from minting transaction metadata.
+func (a *AssetInfo) CIP25() (*NFTMetadata, error) {
+	if a.MintingTxMetadata == nil {
+		return nil, fmt.Errorf("%w: asset has no minting tx metadata", ErrNoData)
+	}
+	var meta TxMetadata
+	if err := json.Unmarshal(*a.MintingTxMetadata, &meta); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCIP25Metadata, err.Error())
+	}
+	return ParseCIP25Metadata(meta, a.PolicyID, a.AssetName)
+}
+
+// CIP25 returns CIP-25 metadata of the asset from most recent
+// minting transaction containing metadata for the asset.
+func (h *AssetHistory) CIP25() (*NFTMetadata, error) {
+	var (
+		res    *NFTMetadata
+		latest Timestamp
+	)
+	for _, tx := range h.MintingTXs {
+		if !tx.Quantity.IsPositive() || (res != nil && !tx.BlockTime.After(latest.Time)) {
+			continue
+		}
+		md, err := tx.CIP25(h.PolicyID, h.AssetName)
+		if err != nil {
+			continue
+		}
+		res, latest = md, tx.BlockTime
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%w: no cip25 metadata for asset %s.%s", ErrNoData, h.PolicyID, h.AssetName)
+	}
+	return res, nil
+}
+
+// CIP25 returns CIP-25 metadata of given asset from mint transaction metadata.
+func (tx *AssetMintTX) CIP25(policy PolicyID, name AssetName) (*NFTMetadata, error) {
+	return ParseCIP25Metadata(tx.Metadata, policy, name)
+}
+
+// ParseCIP25Metadata parses CIP-25 metadata of given asset
+// from transaction metadata with label 721.
+// Version 1 (hex policy id and UTF-8 asset name keys) and
+// version 2 (policy id and asset name as bytes) are supported.
+func ParseCIP25Metadata(meta TxMetadata, policy PolicyID, name AssetName) (*NFTMetadata, error) {
+	raw, ok := meta[MetadataLabelNFT]
+	if !ok {
+		return nil, fmt.Errorf("%w: no metadata with label %s", ErrNoData, MetadataLabelNFT)
+	}
+	var policies map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &policies); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCIP25Metadata, err.Error())
+	}
+
+	md := &NFTMetadata{
+		PolicyID:  policy,
+		AssetName: name,
+		Version:   1,
+	}
+	if v, ok := policies["version"]; ok {
+		if md.Version, ok = cip25Version(v); !ok {
+			return nil, fmt.Errorf("%w: invalid version %s", ErrCIP25Metadata, string(v))
+		}
+	}
+
+	var assets map[string]json.RawMessage
+	if v, ok := cip25Lookup(policies, "", policy.String()); ok {
+		if err := json.Unmarshal(v, &assets); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrCIP25Metadata, err.Error())
+		}
+	}
+	utf8Name, _ := name.UTF8()
+	var props map[string]json.RawMessage
+	if v, ok := cip25Lookup(assets, utf8Name, name.String()); ok {
+		if err := json.Unmarshal(v, &props); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrCIP25Metadata, err.Error())
+		}
+	}
+	if props == nil {
+		return nil, fmt.Errorf("%w: no cip25 metadata for asset %s.%s", ErrNoData, policy, name)
+	}
+
+	for k, v := range props {
+		var field *string
+		switch k {
+		case "name":
+			field = &md.Name
+		case "image":
+			field = &md.Image
+		case "mediaType":
+			field = &md.MediaType
+		case "description":
+			field = &md.Description
+		case "files":
+			if files, ok := cip25Files(v); ok {
+				md.Files = files
+				continue
+			}
+		}
+		if field != nil {
+			if s, ok := cip25String(v); ok {
+				*field = s
+				continue
+			}
+		}
+		if md.Other == nil {
+			md.Other = make(map[string]json.RawMessage)
+		}
+		md.Other[k] = v
+	}
+	return md, nil
+}
+
+// cip25Lookup returns value of key matching UTF-8 name or hex encoded name.
+// Keys are tried in fixed order: exact UTF-8 name, exact hex, hex with 0x prefix
+// and finally hex compared case insensitive in sorted key order,
+// so that result does not depend on map iteration order.
+func cip25Lookup(m map[string]json.RawMessage, utf8Name, hexName string) (json.RawMessage, bool) {
+	for _, k := range []string{utf8Name, hexName, "0x" + hexName} {
+		if v, ok := m[k]; ok && len(k) > 0 {
+			return v, true
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if strings.EqualFold(strings.TrimPrefix(k, "0x"), hexName) {
+			return m[k], true
+		}
+	}
+	return nil, false
+}
+
+// cip25String decodes string or array of string chunks
+// used for values longer than 64 bytes.
+func cip25String(raw json.RawMessage) (string, bool) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, true
+	}
+	var chunks []string
+	if err := json.Unmarshal(raw, &chunks); err != nil {
+		return "", false
+	}
+	return strings.Join(chunks, ""), true
+}
+
+func cip25Version(raw json.RawMessage) (int, bool) {
+	s, ok := cip25String(raw)
+	if !ok {
+		s = string(raw)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 1 {
+		return 0, false
+	}
+	return int(f), true
+}
+
+func cip25Files(raw json.RawMessage) ([]NFTFile, bool) {
+	var list []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, false
+	}
+	files := make([]NFTFile, 0, len(list))
+	for _, item := range list {
+		var file NFTFile
+		for k, v := range item {
+			s, ok := cip25String(v)
+			switch {
+			case ok && k == "name":
+				file.Name = s
+			case ok && k == "mediaType":
+				file.MediaType = s
+			case ok && k == "src":
+				file.Src = s
+			default:
+				if file.Other == nil {
+					file.Other = make(map[string]json.RawMessage)
+				}
+				file.Other[k] = v
+			}
+		}
+		files = append(files, file)
+	}
+	return files, true
+}
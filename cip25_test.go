@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const (
+	cip25Policy = PolicyID("d5e6bf0500378d4f0da4e8dde6becec7621cd8cbf5cbb9b87013d4cc")
+	// CardanoBud
+	cip25Name = AssetName("43617264616e6f427564")
+)
+
+func cip25Meta(t *testing.T, label string) TxMetadata {
+	t.Helper()
+	var meta TxMetadata
+	if err := json.Unmarshal([]byte(`{"721":`+label+`}`), &meta); err != nil {
+		t.Fatal(err)
+	}
+	return meta
+}
+
+func TestParseCIP25Metadata(t *testing.T) {
+	const image = "ipfs://QmRhTTbUrPYEw3mJGGhQqQST9k86v1DPBiTTWJGKDJsVFw"
+	props := `{
+		"name": "SpaceBud #1",
+		"image": ["ipfs://QmRhTTbUrPYEw3mJGGhQqQST9", "k86v1DPBiTTWJGKDJsVFw"],
+		"mediaType": "image/png",
+		"description": ["Space", "Bud"],
+		"files": [
+			{"name": "full", "mediaType": "image/png", "src": ["ipfs://QmRhTTbUrPYEw3mJGGhQqQST9", "k86v1DPBiTTWJGKDJsVFw"]},
+			{"mediaType": "text/html", "src": "ipfs://html", "size": 42}
+		],
+		"traits": ["hat"]
+	}`
+	tests := []struct {
+		name    string
+		label   string
+		version int
+	}{
+		{
+			name:    "v1",
+			label:   `{"` + cip25Policy.String() + `": {"CardanoBud": ` + props + `}}`,
+			version: 1,
+		},
+		{
+			name:    "v1 string version",
+			label:   `{"` + cip25Policy.String() + `": {"CardanoBud": ` + props + `}, "version": "1.0"}`,
+			version: 1,
+		},
+		{
+			name:    "v2 hex keys",
+			label:   `{"0x` + cip25Policy.String() + `": {"0x` + cip25Name.String() + `": ` + props + `}, "version": 2}`,
+			version: 2,
+		},
+		{
+			name:    "v2 uppercase hex keys",
+			label:   `{"0x` + strings.ToUpper(cip25Policy.String()) + `": {"` + strings.ToUpper(cip25Name.String()) + `": ` + props + `}, "version": 2}`,
+			version: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := ParseCIP25Metadata(cip25Meta(t, tt.label), cip25Policy, cip25Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if md.Version != tt.version || md.PolicyID != cip25Policy || md.AssetName != cip25Name {
+				t.Errorf("unexpected asset %s.%s version %d", md.PolicyID, md.AssetName, md.Version)
+			}
+			if md.Name != "SpaceBud #1" || md.Image != image || md.MediaType != "image/png" || md.Description != "SpaceBud" {
+				t.Errorf("unexpected metadata %+v", md)
+			}
+			if len(md.Files) != 2 {
+				t.Fatalf("expected 2 files got %d", len(md.Files))
+			}
+			if f := md.Files[0]; f.Name != "full" || f.MediaType != "image/png" || f.Src != image || f.Other != nil {
+				t.Errorf("unexpected file %+v", f)
+			}
+			if f := md.Files[1]; f.Src != "ipfs://html" || string(f.Other["size"]) != "42" {
+				t.Errorf("unexpected file %+v", f)
+			}
+			if _, ok := md.Other["traits"]; !ok || len(md.Other) != 1 {
+				t.Errorf("expected traits in other got %v", md.Other)
+			}
+		})
+	}
+}
+
+func TestParseCIP25MetadataKeyOrder(t *testing.T) {
+	entry := func(name string) string {
+		return `{"name": "` + name + `", "image": "ipfs://x"}`
+	}
+	upper := strings.ToUpper(cip25Name.String())
+	// differs from upper only in case of last letter, upper sorts first.
+	mixed := strings.ToUpper(cip25Name.String()[:13]) + cip25Name.String()[13:]
+	tests := []struct {
+		name   string
+		assets string
+		want   string
+	}{
+		{
+			name:   "utf8 before hex",
+			assets: `{"0x` + cip25Name.String() + `": ` + entry("hex") + `, "CardanoBud": ` + entry("utf8") + `}`,
+			want:   "utf8",
+		},
+		{
+			name:   "hex before prefixed hex",
+			assets: `{"0x` + cip25Name.String() + `": ` + entry("prefixed") + `, "` + cip25Name.String() + `": ` + entry("hex") + `}`,
+			want:   "hex",
+		},
+		{
+			name:   "exact hex before case folded",
+			assets: `{"` + upper + `": ` + entry("upper") + `, "0x` + cip25Name.String() + `": ` + entry("exact") + `}`,
+			want:   "exact",
+		},
+		{
+			name:   "case folded in sorted order",
+			assets: `{"` + mixed + `": ` + entry("mixed") + `, "` + upper + `": ` + entry("upper") + `}`,
+			want:   "upper",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := cip25Meta(t, `{"`+cip25Policy.String()+`": `+tt.assets+`}`)
+			// map iteration order is random, result must not depend on it.
+			for i := 0; i < 20; i++ {
+				md, err := ParseCIP25Metadata(meta, cip25Policy, cip25Name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if md.Name != tt.want {
+					t.Fatalf("expected %s got %s", tt.want, md.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCIP25MetadataInvalid(t *testing.T) {
+	valid := `{"name": "x"}`
+	tests := []struct {
+		name  string
+		label string
+		err   error
+	}{
+		{name: "other policy", label: `{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209": {"CardanoBud": ` + valid + `}}`, err: ErrNoData},
+		{name: "other asset", label: `{"` + cip25Policy.String() + `": {"CardanoBud2": ` + valid + `}}`, err: ErrNoData},
+		{name: "invalid version", label: `{"` + cip25Policy.String() + `": {"CardanoBud": ` + valid + `}, "version": "x"}`, err: ErrCIP25Metadata},
+		{name: "invalid assets", label: `{"` + cip25Policy.String() + `": []}`, err: ErrCIP25Metadata},
+		{name: "invalid props", label: `{"` + cip25Policy.String() + `": {"CardanoBud": "x"}}`, err: ErrCIP25Metadata},
+		{name: "invalid label", label: `[]`, err: ErrCIP25Metadata},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCIP25Metadata(cip25Meta(t, tt.label), cip25Policy, cip25Name); !errors.Is(err, tt.err) {
+				t.Errorf("expected %v got %v", tt.err, err)
+			}
+		})
+	}
+	if _, err := ParseCIP25Metadata(TxMetadata{}, cip25Policy, cip25Name); !errors.Is(err, ErrNoData) {
+		t.Errorf("expected ErrNoData without label got %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+type (
+	// CIP68Metadata is metadata of CIP-68 reference datum.
+	// See https://cips.cardano.org/cip/CIP-0068
+	CIP68Metadata struct {
+		Name        string      `json:"name,omitempty"`
+		Image       string      `json:"image,omitempty"`
+		MediaType   string      `json:"mediaType,omitempty"`
+		Description string      `json:"description,omitempty"`
+		Files       []CIP68File `json:"files,omitempty"`
+		// Ticker, URL, Logo and Decimals are used by fungible tokens (333).
+		Ticker   string `json:"ticker,omitempty"`
+		URL      string `json:"url,omitempty"`
+		Logo     string `json:"logo,omitempty"`
+		Decimals uint8  `json:"decimals,omitempty"`
+		// Version of datum format.
+		Version int64 `json:"version"`
+		// Extra is optional third field of datum with custom data.
+		Extra *PlutusData `json:"extra,omitempty"`
+		// Other metadata entries which are not known to the library
+		// or could not be decoded as text. Keys which are not UTF-8
+		// byte strings are hex encoded bytes or Plutus data JSON.
+		Other map[string]PlutusData `json:"other,omitempty"`
+	}
+
+	// CIP68File is entry of CIP-68 metadata files.
+	CIP68File struct {
+		Name      string                `json:"name,omitempty"`
+		MediaType string                `json:"mediaType,omitempty"`
+		Src       string                `json:"src"`
+		Other     map[string]PlutusData `json:"other,omitempty"`
+	}
+)
+
+// CIP68 decodes CIP-68 metadata of the asset.
+func (a *AssetInfo) CIP68() (*CIP68Metadata, error) {
+	if a.CIP68Metadata == nil {
+		return nil, fmt.Errorf("%w: asset has no cip68 metadata", ErrNoData)
+	}
+	label, _ := a.AssetName.Label()
+	return decodeCIP68Metadata(*a.CIP68Metadata, label)
+}
+
+// CIP68 decodes datum value as CIP-68 metadata.
+func (d *DatumInfo) CIP68() (*CIP68Metadata, error) {
+	if d.Value == nil {
+		return nil, fmt.Errorf("%w: datum has no value", ErrNoData)
+	}
+	return DecodeCIP68Metadata(*d.Value)
+}
+
+// CIP68 decodes inline datum of the UTxO as CIP-68 metadata.
+func (u *UTxO) CIP68() (*CIP68Metadata, error) {
+	if u.InlineDatum == nil {
+		return nil, fmt.Errorf("%w: utxo has no inline datum", ErrNoData)
+	}
+	b, err := json.Marshal(u.InlineDatum)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeCIP68Metadata(b)
+}
+
+// DecodeCIP68Metadata decodes CIP-68 metadata from JSON encoded datum.
+// Input can be Plutus data in detailed JSON schema, inline datum
+// object with "value" field or object keyed by CIP-67 label.
+func DecodeCIP68Metadata(b []byte) (*CIP68Metadata, error) {
+	return decodeCIP68Metadata(b, 0)
+}
+
+func decodeCIP68Metadata(b []byte, label AssetLabel) (*CIP68Metadata, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrCIP68Metadata, err.Error())
+	}
+	if _, ok := obj["constructor"]; ok {
+		var datum PlutusData
+		if err := json.Unmarshal(b, &datum); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrCIP68Metadata, err.Error())
+		}
+		return CIP68MetadataFromDatum(datum)
+	}
+	if v, ok := obj["value"]; ok {
+		return decodeCIP68Metadata(v, label)
+	}
+	if v, ok := obj[label.String()]; ok && label != 0 {
+		return decodeCIP68Metadata(v, 0)
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if md, err := decodeCIP68Metadata(obj[k], 0); err == nil {
+			return md, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no datum found", ErrCIP68Metadata)
+}
+
+// CIP68MetadataFromDatum decodes CIP-68 metadata from reference datum
+// Constr 0 [metadata, version, extra].
+func CIP68MetadataFromDatum(datum PlutusData) (*CIP68Metadata, error) {
+	if datum.Kind != PlutusDataConstr || datum.Constructor != 0 || len(datum.Fields) < 2 {
+		return nil, fmt.Errorf("%w: datum must be constructor 0 with at least 2 fields", ErrCIP68Metadata)
+	}
+	meta := datum.Fields[0]
+	if meta.Kind != PlutusDataMap {
+		return nil, fmt.Errorf("%w: metadata must be map", ErrCIP68Metadata)
+	}
+	version, ok := datum.Fields[1].Int64()
+	if !ok {
+		return nil, fmt.Errorf("%w: version must be int", ErrCIP68Metadata)
+	}
+
+	md := &CIP68Metadata{Version: version}
+	if len(datum.Fields) > 2 {
+		extra := datum.Fields[2]
+		md.Extra = &extra
+	}
+	for _, kv := range meta.Map {
+		key, ok := kv.Key.Text()
+		if !ok {
+			md.other(cip68Key(kv.Key), kv.Value)
+			continue
+		}
+		if key == "decimals" {
+			if n, ok := kv.Value.Int64(); ok && n >= 0 && n <= 255 {
+				md.Decimals = uint8(n)
+				continue
+			}
+		}
+		if key == "files" && kv.Value.Kind == PlutusDataList {
+			if files, ok := cip68Files(kv.Value.List); ok {
+				md.Files = files
+				continue
+			}
+		}
+		var field *string
+		switch key {
+		case "name":
+			field = &md.Name
+		case "image":
+			field = &md.Image
+		case "mediaType":
+			field = &md.MediaType
+		case "description":
+			field = &md.Description
+		case "ticker":
+			field = &md.Ticker
+		case "url":
+			field = &md.URL
+		case "logo":
+			field = &md.Logo
+		}
+		if field != nil {
+			if s, ok := kv.Value.Text(); ok {
+				*field = s
+				continue
+			}
+		}
+		md.other(key, kv.Value)
+	}
+	return md, nil
+}
+
+func (md *CIP68Metadata) other(key string, v PlutusData) {
+	if md.Other == nil {
+		md.Other = make(map[string]PlutusData)
+	}
+	md.Other[key] = v
+}
+
+// cip68Key returns map key of non text key, bytes are hex encoded
+// and other values are encoded as Plutus data JSON so that keys do not collide.
+func cip68Key(key PlutusData) string {
+	if key.Kind == PlutusDataBytes {
+		return key.Hex()
+	}
+	b, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Sprintf("invalid plutus data kind %d", key.Kind)
+	}
+	return string(b)
+}
+
+func cip68Files(list []PlutusData) ([]CIP68File, bool) {
+	files := make([]CIP68File, 0, len(list))
+	for _, item := range list {
+		if item.Kind != PlutusDataMap {
+			return nil, false
+		}
+		var file CIP68File
+		for _, kv := range item.Map {
+			key, ok := kv.Key.Text()
+			if !ok {
+				return nil, false
+			}
+			s, ok := kv.Value.Text()
+			switch {
+			case ok && key == "name":
+				file.Name = s
+			case ok && key == "mediaType":
+				file.MediaType = s
+			case ok && key == "src":
+				file.Src = s
+			default:
+				if file.Other == nil {
+					file.Other = make(map[string]PlutusData)
+				}
+				file.Other[key] = kv.Value
+			}
+		}
+		files = append(files, file)
+	}
+	return files, true
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// pdText returns Plutus data JSON of UTF-8 byte string.
+func pdText(s string) string {
+	return fmt.Sprintf(`{"bytes":%q}`, hex.EncodeToString([]byte(s)))
+}
+
+// pdMap returns Plutus data JSON of map with text keys and raw JSON values,
+// kv holds key value pairs.
+func pdMap(kv ...string) string {
+	pairs := make([]string, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`{"k":%s,"v":%s}`, pdText(kv[i]), kv[i+1]))
+	}
+	return `{"map":[` + strings.Join(pairs, ",") + `]}`
+}
+
+func pdDatum(meta string, version int, extra string) string {
+	fields := []string{meta, fmt.Sprintf(`{"int":%d}`, version)}
+	if extra != "" {
+		fields = append(fields, extra)
+	}
+	return `{"constructor":0,"fields":[` + strings.Join(fields, ",") + `]}`
+}
+
+func TestCIP68Metadata(t *testing.T) {
+	const image = "ipfs://QmRhTTbUrPYEw3mJGGhQqQST9k86v1DPBiTTWJGKDJsVFw"
+
+	t.Run("222", func(t *testing.T) {
+		meta := pdMap(
+			"name", pdText("SpaceBud #1"),
+			// image split into 32 byte chunks.
+			"image", `{"list":[`+pdText(image[:32])+`,`+pdText(image[32:])+`]}`,
+			"mediaType", pdText("image/png"),
+			"files", `{"list":[`+pdMap("src", pdText(image), "mediaType", pdText("image/png"), "name", pdText("/0"), "size", `{"int":5}`)+`]}`,
+			"traits", `{"list":[`+pdText("hat")+`]}`,
+		)
+		md, err := DecodeCIP68Metadata([]byte(pdDatum(meta, 1, `{"constructor":0,"fields":[]}`)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if md.Name != "SpaceBud #1" || md.Image != image || md.MediaType != "image/png" || md.Version != 1 {
+			t.Errorf("unexpected metadata %+v", md)
+		}
+		if len(md.Files) != 1 || md.Files[0].Src != image || md.Files[0].Name != "/0" || md.Files[0].MediaType != "image/png" {
+			t.Fatalf("unexpected files %+v", md.Files)
+		}
+		if size, ok := md.Files[0].Other["size"].Int64(); !ok || size != 5 {
+			t.Errorf("unexpected file size %v", md.Files[0].Other)
+		}
+		if traits, ok := md.Other["traits"]; !ok || traits.Kind != PlutusDataList {
+			t.Errorf("expected traits in other got %v", md.Other)
+		}
+		if md.Extra == nil || md.Extra.Kind != PlutusDataConstr {
+			t.Errorf("expected constructor extra got %v", md.Extra)
+		}
+
+		// datum keyed by label as returned by asset info.
+		wrapped := fmt.Sprintf(`{"222":%s}`, pdDatum(meta, 1, ""))
+		md, err = decodeCIP68Metadata([]byte(wrapped), AssetLabelNFT)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if md.Name != "SpaceBud #1" || md.Extra != nil {
+			t.Errorf("unexpected metadata %+v", md)
+		}
+	})
+
+	t.Run("333", func(t *testing.T) {
+		meta := pdMap(
+			"name", pdText("Cardano Token"),
+			"description", pdText("Fungible token"),
+			"ticker", pdText("TKN"),
+			"url", pdText("https://example.com"),
+			"logo", pdText("ipfs://logo"),
+			"decimals", `{"int":6}`,
+		)
+		md, err := DecodeCIP68Metadata([]byte(`{"value":` + pdDatum(meta, 1, "") + `}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := CIP68Metadata{
+			Name:        "Cardano Token",
+			Description: "Fungible token",
+			Ticker:      "TKN",
+			URL:         "https://example.com",
+			Logo:        "ipfs://logo",
+			Decimals:    6,
+			Version:     1,
+		}
+		if md.Name != want.Name || md.Description != want.Description || md.Ticker != want.Ticker ||
+			md.URL != want.URL || md.Logo != want.Logo || md.Decimals != want.Decimals ||
+			md.Version != want.Version || len(md.Other) != 0 {
+			t.Errorf("expected %+v got %+v", want, md)
+		}
+	})
+
+	t.Run("444", func(t *testing.T) {
+		meta := pdMap("name", pdText("Rich FT"), "image", pdText("ipfs://QmXyz"), "decimals", `{"int":2}`)
+		md, err := DecodeCIP68Metadata([]byte(pdDatum(meta, 2, "")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if md.Name != "Rich FT" || md.Image != "ipfs://QmXyz" || md.Decimals != 2 || md.Version != 2 {
+			t.Errorf("unexpected metadata %+v", md)
+		}
+	})
+}
+
+func TestCIP68MetadataDecimals(t *testing.T) {
+	tests := []struct {
+		value string
+		want  uint8
+		other bool
+	}{
+		{value: `{"int":0}`, want: 0},
+		{value: `{"int":255}`, want: 255},
+		{value: `{"int":256}`, other: true},
+		{value: `{"int":-1}`, other: true},
+		{value: `{"int":18446744073709551616}`, other: true},
+		{value: pdText("6"), other: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			md, err := DecodeCIP68Metadata([]byte(pdDatum(pdMap("decimals", tt.value), 1, "")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, other := md.Other["decimals"]
+			if md.Decimals != tt.want || other != tt.other {
+				t.Errorf("expected decimals %d other %t got %d %t", tt.want, tt.other, md.Decimals, other)
+			}
+		})
+	}
+}
+
+func TestCIP68MetadataKeys(t *testing.T) {
+	meta := `{"map":[` +
+		`{"k":{"int":1},"v":` + pdText("one") + `},` +
+		`{"k":{"int":2},"v":` + pdText("two") + `},` +
+		`{"k":{"list":[{"int":1}]},"v":` + pdText("list") + `},` +
+		`{"k":{"map":[]},"v":` + pdText("map") + `},` +
+		`{"k":{"bytes":"ff"},"v":` + pdText("bytes") + `}` +
+		`]}`
+	md, err := DecodeCIP68Metadata([]byte(pdDatum(meta, 1, "")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		`{"int":1}`:            "one",
+		`{"int":2}`:            "two",
+		`{"list":[{"int":1}]}`: "list",
+		`{"map":[]}`:           "map",
+		"ff":                   "bytes",
+	}
+	if len(md.Other) != len(want) {
+		t.Fatalf("expected %d entries got %d: %v", len(want), len(md.Other), md.Other)
+	}
+	for k, v := range want {
+		if got, _ := md.Other[k].Text(); got != v {
+			t.Errorf("key %s: expected %s got %s", k, v, got)
+		}
+	}
+}
+
+func TestCIP68MetadataInvalid(t *testing.T) {
+	tests := map[string]string{
+		"not json":        `[`,
+		"constructor 1":   `{"constructor":1,"fields":[{"map":[]},{"int":1}]}`,
+		"missing version": `{"constructor":0,"fields":[{"map":[]}]}`,
+		"list metadata":   `{"constructor":0,"fields":[{"list":[]},{"int":1}]}`,
+		"bytes version":   `{"constructor":0,"fields":[{"map":[]},{"bytes":""}]}`,
+		"no datum":        `{"foo":{"int":1}}`,
+	}
+	for name, in := range tests {
+		if _, err := DecodeCIP68Metadata([]byte(in)); !errors.Is(err, ErrCIP68Metadata) {
+			t.Errorf("%s: expected ErrCIP68Metadata got %v", name, err)
+		}
+	}
+}
@@ -5,55 +5,44 @@
 package koios
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptrace"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
-
-	"golang.org/x/time/rate"
 )
 
 type (
 	// Client is api client instance.
 	Client struct {
-		r               *rate.Limiter
-		reqStatsEnabled bool
-		url             *url.URL
-		client          *http.Client
-		commonHeaders   http.Header
-		locked          bool
-		auth            *AuthInfo
+		// mu serializes configuration changes,
+		// readers always load active snapshot.
+		mu  sync.Mutex
+		cfg atomic.Pointer[config]
 	}
 )
 
 // WithOptions returns new light clone of client with modified options applied.
+// Clone carries complete configuration of the client including auth.
 func (c *Client) WithOptions(opts ...Option) (*Client, error) {
-	nc := &Client{
-		r:               c.r,
-		reqStatsEnabled: c.reqStatsEnabled,
-		commonHeaders:   c.commonHeaders.Clone(),
-	}
-	u, uerr := url.Parse(c.url.String())
-	nc.url = u
-
-	if nc.client == nil {
-		nc.client = c.client
-	}
-
-	// Apply provided options
-	for _, opt := range opts {
-		if err := opt.apply(nc); err != nil {
-			return nil, err
-		}
+	cfg := c.config().clone()
+	cfg.locked = false
+	if err := cfg.apply(opts...); err != nil {
+		return nil, err
 	}
-	nc.locked = true
+	cfg.locked = true
 
-	return nc, uerr
+	nc := &Client{}
+	nc.cfg.Store(cfg)
+	return nc, nil
 }
 
 // HEAD sends api http HEAD request to provided relative path with query params
@@ -91,14 +80,52 @@ func (c *Client) GET(
 	return c.request(ctx, nil, "GET", path, nil, opts)
 }
 
+// Call sends api request with given method to provided relative path
+// and unmarshals json response into typed response data.
+// It can be used to call custom endpoints or endpoints which
+// are not (yet) wrapped by this library.
+//
+// Payload can be nil, io.Reader, []byte or any value which will be
+// encoded as json request body.
+// e.g.
+//
+//	res, err := koios.Call[[]koios.Tip](ctx, api, "GET", "/tip", nil, nil)
+func Call[T any](
+	ctx context.Context,
+	c *Client,
+	method string,
+	path string,
+	payload any,
+	opts *RequestOptions,
+) (*Response[T], error) {
+	res := &Response[T]{}
+	body, err := requestPayload(payload)
+	if err != nil {
+		res.RequestMethod = method
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, method, path, body, opts)
+	if err != nil {
+		if rsp != nil {
+			b, _ := ReadResponseBody(rsp)
+			res.applyError(b, err)
+		}
+		return res, err
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return res, err
+}
+
 // BaseURL returns currently used base url e.g. https://api.koios.rest/api/v0
 func (c *Client) BaseURL() string {
-	return c.url.String()
+	return c.config().url.String()
 }
 
 // ServerURL returns currently used server url e.g. https://api.koios.rest/
 func (c *Client) ServerURL() *url.URL {
-	return c.url.ResolveReference(&url.URL{Path: "/"})
+	return c.config().url.ResolveReference(&url.URL{Path: "/"})
 }
 
 func (c *Client) NewRequestOptions() *RequestOptions {
@@ -106,28 +133,58 @@ func (c *Client) NewRequestOptions() *RequestOptions {
 		pageSize: PageSize,
 		page:     1,
 		query:    url.Values{},
-		headers:  c.commonHeaders.Clone(),
+		headers:  http.Header{},
+	}
+}
+
+// requestOptions returns per request copy of provided options,
+// so that options passed by caller are never modified and
+// can be reused across requests.
+func (c *Client) requestOptions(opts *RequestOptions) *RequestOptions {
+	if opts == nil {
+		return c.NewRequestOptions()
 	}
+	return opts.Clone()
 }
 
 func (c *Client) request(
 	ctx context.Context,
-	res *Response,
+	res *ResponseMeta,
 	method string,
 	path string,
 	body io.Reader,
 	opts *RequestOptions,
 ) (*http.Response, error) {
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	// use single configuration snapshot for entire request.
+	cfg := c.config()
+	if cfg.network != nil {
+		if err := c.verifyNetwork(ctx, cfg); err != nil {
+			if res != nil {
+				res.applyError(nil, err)
+			}
+			return nil, err
+		}
 	}
+	return c.do(ctx, cfg, res, method, path, body, opts)
+}
 
+// do sends request using given configuration snapshot.
+func (c *Client) do(
+	ctx context.Context,
+	cfg *config,
+	res *ResponseMeta,
+	method string,
+	path string,
+	body io.Reader,
+	opts *RequestOptions,
+) (*http.Response, error) {
+	opts = c.requestOptions(opts)
 	if err := opts.lock(); err != nil {
 		return nil, err
 	}
 
 	path = strings.TrimLeft(path, "/")
-	requrl := c.url.ResolveReference(&url.URL{Path: path, RawQuery: opts.query.Encode()}).String()
+	requrl := cfg.url.ResolveReference(&url.URL{Path: path, RawQuery: opts.query.Encode()}).String()
 
 	if res != nil {
 		res.RequestURL = requrl
@@ -143,23 +200,21 @@ func (c *Client) request(
 	}
 
 	// handle rate limit
-	if err := c.r.Wait(ctx); err != nil {
+	if err := cfg.r.Wait(ctx); err != nil {
 		return nil, err
 	}
 
-	if auth := c.getAuth(); auth.token != "" {
-		opts.HeaderAdd("Authorization", "Bearer "+auth.token)
-	}
-	c.applyReqHeaders(req, opts.headers)
+	cfg.applyAuth(opts)
+	cfg.applyReqHeaders(req, opts.headers)
 
 	var (
 		eqerr error
 		rsp   *http.Response
 	)
-	if res != nil && c.reqStatsEnabled {
-		rsp, eqerr = c.requestWithStats(req, res, opts.requestsToday)
+	if res != nil && cfg.reqStatsEnabled {
+		rsp, eqerr = cfg.requestWithStats(req, res, opts.requestsToday)
 	} else {
-		rsp, eqerr = c.client.Do(req)
+		rsp, eqerr = cfg.client.Do(req)
 	}
 
 	if ctx.Err() != nil {
@@ -187,8 +242,15 @@ func (c *Client) request(
 	return rsp, nil
 }
 
-func (c *Client) applyReqHeaders(req *http.Request, headers http.Header) {
+func (cfg *config) applyReqHeaders(req *http.Request, headers http.Header) {
+	for name, values := range cfg.commonHeaders {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	// request specific headers override common headers.
 	for name, values := range headers {
+		req.Header.Del(name)
 		for _, value := range values {
 			req.Header.Add(name, value)
 		}
@@ -198,9 +260,9 @@ func (c *Client) applyReqHeaders(req *http.Request, headers http.Header) {
 	}
 }
 
-func (c *Client) requestWithStats(req *http.Request, res *Response, requestsToday uint) (*http.Response, error) {
+func (cfg *config) requestWithStats(req *http.Request, res *ResponseMeta, requestsToday uint) (*http.Response, error) {
 	res.Stats = &RequestStats{
-		Auth:          c.getAuth(),
+		Auth:          cfg.getAuth(),
 		RequstesToday: requestsToday,
 	}
 	var dns, tlshs, connect time.Time
@@ -233,7 +295,7 @@ func (c *Client) requestWithStats(req *http.Request, res *Response, requestsToda
 		),
 	)
 	res.Stats.ReqStartedAt = time.Now().UTC()
-	rsp, err := c.client.Transport.RoundTrip(req)
+	rsp, err := cfg.client.Transport.RoundTrip(req)
 
 	if err != nil {
 		res.applyError(nil, err)
@@ -244,16 +306,22 @@ func (c *Client) requestWithStats(req *http.Request, res *Response, requestsToda
 	return rsp, nil
 }
 
-func (c *Client) setBaseURL(schema, host, version string, port uint16) error {
-	raw := fmt.Sprintf("%s://%s", schema, host)
-	if port != 80 && port != 443 {
-		raw = fmt.Sprintf("%s:%d", raw, port)
+func requestPayload(payload any) (io.Reader, error) {
+	switch pl := payload.(type) {
+	case nil:
+		return nil, nil
+	case io.Reader:
+		return pl, nil
+	case []byte:
+		return bytes.NewReader(pl), nil
+	case string:
+		return strings.NewReader(pl), nil
 	}
-	raw += "/api/" + version + "/"
-	u, err := url.ParseRequestURI(raw)
+	// encode before request is sent so that encoding
+	// errors are reported to the caller.
+	b, err := json.Marshal(payload)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	c.url = u
-	return nil
+	return bytes.NewReader(b), nil
 }
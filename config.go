@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"golang.org/x/time/rate"
+)
+
+// config is immutable snapshot of client configuration.
+// Options are always applied to a copy of current configuration
+// which then replaces the active snapshot atomically.
+type config struct {
+	r               *rate.Limiter
+	reqStatsEnabled bool
+	url             *url.URL
+	client          *http.Client
+	tls             []func(*tls.Config)
+	commonHeaders   http.Header
+	locked          bool
+	auth            *AuthInfo
+	authHeader      http.Header
+	network         *Network
+	netcheck        *networkCheck
+	noValidation    bool
+	tip             *lastTip
+}
+
+// Reconfigure applies provided options to the client at runtime.
+// Options are applied to a copy of current configuration which is
+// validated and then atomically swapped, so requests already in flight
+// complete with previous configuration while new requests use the new one.
+// When any of the options fails, configuration of the client is not changed.
+//
+// http.Client can not be replaced with Reconfigure, use WithOptions to
+// create new client instead.
+func (c *Client) Reconfigure(opts ...Option) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cfg := c.config().clone()
+	if err := cfg.apply(opts...); err != nil {
+		return err
+	}
+	c.cfg.Store(cfg)
+	return nil
+}
+
+// config returns currently active configuration snapshot.
+func (c *Client) config() *config {
+	return c.cfg.Load()
+}
+
+func (cfg *config) clone() *config {
+	u := *cfg.url
+	ncfg := &config{
+		r:               cfg.r,
+		reqStatsEnabled: cfg.reqStatsEnabled,
+		url:             &u,
+		client:          cfg.client,
+		tls:             slices.Clone(cfg.tls),
+		commonHeaders:   cfg.commonHeaders.Clone(),
+		locked:          cfg.locked,
+		authHeader:      cfg.authHeader.Clone(),
+		noValidation:    cfg.noValidation,
+		// configuration may change the host, so tip is not carried over.
+		tip: &lastTip{},
+	}
+	if cfg.auth != nil {
+		auth := *cfg.auth
+		ncfg.auth = &auth
+	}
+	if cfg.network != nil {
+		// configuration may change the host, so verify network again.
+		network := *cfg.network
+		ncfg.network = &network
+		ncfg.netcheck = &networkCheck{}
+	}
+	return ncfg
+}
+
+func (cfg *config) apply(opts ...Option) error {
+	for _, opt := range opts {
+		if err := opt.apply(cfg); err != nil {
+			return err
+		}
+	}
+	return cfg.validate()
+}
+
+func (cfg *config) validate() error {
+	if cfg.client == nil {
+		return ErrHTTPClientNotSet
+	}
+	if cfg.client.Timeout == 0 {
+		return ErrHTTPClientTimeoutSetting
+	}
+	if cfg.r == nil {
+		return ErrRateLimitRange
+	}
+	if cfg.url.Scheme != "http" && cfg.url.Scheme != "https" {
+		return ErrSchema
+	}
+	if cfg.url.Host == "" {
+		return fmt.Errorf("%w: missing host", ErrURL)
+	}
+	return nil
+}
+
+// applyAuth adds auth headers to the request options.
+func (cfg *config) applyAuth(opts *RequestOptions) {
+	for name, values := range cfg.authHeader {
+		for _, value := range values {
+			opts.HeaderSet(name, value)
+		}
+	}
+	if auth := cfg.getAuth(); auth.token != "" && cfg.authHeader.Get("Authorization") == "" {
+		opts.HeaderSet("Authorization", "Bearer "+auth.token)
+	}
+}
+
+func (cfg *config) setBaseURL(schema, host, version string, port uint16) error {
+	raw := fmt.Sprintf("%s://%s", schema, host)
+	if port != 80 && port != 443 {
+		raw = fmt.Sprintf("%s:%d", raw, port)
+	}
+	raw += "/api/" + version + "/"
+	u, err := url.ParseRequestURI(raw)
+	if err != nil {
+		return err
+	}
+	cfg.url = u
+	return nil
+}
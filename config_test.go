@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestReconfigureConcurrent is meant to be run with -race,
+// requests in flight must see single consistent configuration snapshot.
+func TestReconfigureConcurrent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a, b := r.Header.Get("X-A"), r.Header.Get("X-B"); a != b {
+			t.Errorf("headers of different configurations mixed: %s %s", a, b)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"epoch_no":1}]`))
+	}))
+	defer srv.Close()
+
+	c, err := New(BaseURL(srv.URL+"/api/v1/"), RateLimit(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 25; j++ {
+				if _, err := c.GetTip(ctx, nil); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			v := strconv.Itoa(i)
+			if err := c.Reconfigure(Header("X-A", v), Header("X-B", v), Timeout(time.Duration(i+1)*time.Second)); err != nil {
+				t.Error(err)
+				return
+			}
+			// failed options must not change configuration.
+			if err := c.Reconfigure(Header("X-A", "invalid"), RateLimit(0)); !errors.Is(err, ErrRateLimitRange) {
+				t.Errorf("expected ErrRateLimitRange got %v", err)
+			}
+		}
+	}()
+	wg.Wait()
+
+	if got := c.config().commonHeaders.Get("X-A"); got != "49" {
+		t.Errorf("expected last configuration to be active got header %q", got)
+	}
+	if got := c.config().client.Timeout; got != 50*time.Second {
+		t.Errorf("expected timeout 50s got %s", got)
+	}
+}
+
+func TestConfigClone(t *testing.T) {
+	c, err := New(WithNetwork(Mainnet), Header("X-A", "a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := c.config()
+	cfg.tip.set(&Tip{EpochNo: 500})
+	cfg.netcheck.done = true
+	cfg.netcheck.err = ErrNetworkMismatch
+
+	clone := cfg.clone()
+	if clone.tip == cfg.tip || clone.tip.get() != nil {
+		t.Error("cached tip is carried over to clone")
+	}
+	if clone.netcheck == cfg.netcheck || clone.netcheck.done || clone.netcheck.err != nil {
+		t.Error("network check is carried over to clone")
+	}
+	if clone.network == cfg.network || *clone.network != *cfg.network {
+		t.Error("network profile is not copied")
+	}
+	if clone.url == cfg.url || clone.url.String() != cfg.url.String() {
+		t.Error("url is not copied")
+	}
+
+	clone.commonHeaders.Set("X-A", "b")
+	if got := cfg.commonHeaders.Get("X-A"); got != "a" {
+		t.Errorf("headers of original config modified: %s", got)
+	}
+	if got := cfg.tip.get(); got == nil || got.EpochNo != 500 {
+		t.Errorf("tip of original config changed: %v", got)
+	}
+
+	if err := c.Reconfigure(Header("X-A", "c")); err != nil {
+		t.Fatal(err)
+	}
+	if c.config().tip.get() != nil || c.config().netcheck.done {
+		t.Error("reconfigured client uses cached tip or network check")
+	}
+}
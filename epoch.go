@@ -17,6 +17,14 @@ type (
 	// EpochNo defines type for _epoch_no.
 	EpochNo uint
 
+	// EpochRange defines inclusive range of epochs used to filter
+	// results of history endpoints. Zero From or To leaves that side
+	// of the range open.
+	EpochRange struct {
+		From EpochNo
+		To   EpochNo
+	}
+
 	// EpochInfo defines model for epoch_info.
 	EpochInfo struct {
 		// Epoch number
@@ -58,10 +66,7 @@ type (
 	}
 
 	// EpochInfoResponse response of /epoch_info.
-	EpochInfoResponse struct {
-		Response
-		Data []EpochInfo `json:"data"`
-	}
+	EpochInfoResponse = ListResponse[EpochInfo]
 
 	// EpochParams defines model for epoch_params.
 	EpochParams struct {
@@ -132,13 +137,12 @@ type (
 		MaxCollateralInputs int `json:"max_collateral_inputs"`
 		// The cost per UTxO word
 		CoinsPerUtxoSize decimal.Decimal `json:"coins_per_utxo_size"`
+		// The cost per byte of reference scripts (Conway)
+		MinFeeRefScriptCostPerByte decimal.Decimal `json:"min_fee_ref_script_cost_per_byte"`
 	}
 
 	// EpochParamsResponse response of /epoch_params.
-	EpochParamsResponse struct {
-		Response
-		Data []EpochParams `json:"data"`
-	}
+	EpochParamsResponse = ListResponse[EpochParams]
 
 	BlockProtocol struct {
 		// The protocol major version
@@ -150,10 +154,7 @@ type (
 		Blocks int `json:"blocks"`
 	}
 
-	EpochBlockProtocolsResponse struct {
-		Response
-		Data []BlockProtocol `json:"data"`
-	}
+	EpochBlockProtocolsResponse = ListResponse[BlockProtocol]
 )
 
 // GetEpochInfo returns the epoch information, all epochs if no epoch specified.
@@ -164,9 +165,15 @@ func (c *Client) GetEpochInfo(
 	opts *RequestOptions,
 ) (res *EpochInfoResponse, err error) {
 	res = &EpochInfoResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	if !opts.query.Has("order") {
 		opts.query.Set("order", "epoch_no.desc")
 	}
@@ -177,11 +184,11 @@ func (c *Client) GetEpochInfo(
 		opts.QuerySet("_include_next_epoch", "true")
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/epoch_info", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/epoch_info", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -193,19 +200,25 @@ func (c *Client) GetEpochParams(
 	opts *RequestOptions,
 ) (res *EpochParamsResponse, err error) {
 	res = &EpochParamsResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/epoch_params", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/epoch_params", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	if len(res.Data) == 0 {
 		return nil, fmt.Errorf("%w: could not get epoch params %s", ErrResponse, epoch)
@@ -220,22 +233,38 @@ func (c *Client) GetEpochBlockProtocols(
 	opts *RequestOptions,
 ) (res *EpochBlockProtocolsResponse, err error) {
 	res = &EpochBlockProtocolsResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/epoch_block_protocols", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/epoch_block_protocols", nil, opts)
 	if err != nil {
 		return
 	}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	if len(res.Data) == 0 {
 		return nil, fmt.Errorf("%w: could not get epoch block protocols %s", ErrResponse, epoch)
 	}
 	return
 }
+
+// applyQuery adds epoch_no range filters to request query.
+func (r EpochRange) applyQuery(opts *RequestOptions) {
+	if r.From > 0 {
+		opts.QueryAdd("epoch_no", "gte."+r.From.String())
+	}
+	if r.To > 0 {
+		opts.QueryAdd("epoch_no", "lte."+r.To.String())
+	}
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// ExUnits are execution units of a redeemer.
+	ExUnits struct {
+		Mem   uint64 `json:"mem"`
+		Steps uint64 `json:"steps"`
+	}
+
+	// FeeInput describes transaction fee is estimated for.
+	FeeInput struct {
+		// Tx is serialized transaction, used to compute size when Size is zero.
+		// Transaction should include all witnesses, since fee depends on
+		// size of signed transaction.
+		Tx *TxBodyJSON `json:"tx,omitempty"`
+		// Size of serialized transaction in bytes.
+		Size uint64 `json:"size,omitempty"`
+		// Redeemers are execution units of all redeemers in transaction.
+		Redeemers []ExUnits `json:"redeemers,omitempty"`
+		// RefScriptSize is total size in bytes of reference scripts
+		// in spent and reference inputs, see ReferenceScriptSize.
+		RefScriptSize uint64 `json:"ref_script_size,omitempty"`
+	}
+
+	// FeeEstimate is minimum fee of transaction and its components.
+	FeeEstimate struct {
+		EpochNo EpochNo `json:"epoch_no"`
+		// Size of transaction in bytes.
+		Size uint64 `json:"size"`
+		// SizeFee is min_fee_a * size + min_fee_b.
+		SizeFee decimal.Decimal `json:"size_fee"`
+		// ExUnits are total execution units of all redeemers.
+		ExUnits ExUnits `json:"ex_units"`
+		// ExUnitsFee is price_mem * mem + price_step * steps rounded up.
+		ExUnitsFee decimal.Decimal `json:"ex_units_fee"`
+		// RefScriptSize is total size of reference scripts.
+		RefScriptSize uint64 `json:"ref_script_size"`
+		// RefScriptFee is tiered fee of reference scripts.
+		RefScriptFee decimal.Decimal `json:"ref_script_fee"`
+		// MinFee is sum of all fee components in lovelace.
+		MinFee decimal.Decimal `json:"min_fee"`
+		// Headroom left against per transaction limits,
+		// negative when limit is exceeded.
+		SizeHeadroom  int64 `json:"size_headroom"`
+		MemHeadroom   int64 `json:"mem_headroom"`
+		StepsHeadroom int64 `json:"steps_headroom"`
+	}
+)
+
+// refScriptTierSize is size of reference script fee tier,
+// price per byte is multiplied by refScriptTierMultiplier for every tier.
+const refScriptTierSize = 25 * 1024
+
+var refScriptTierMultiplier = decimal.RequireFromString("1.2") //nolint: gochecknoglobals
+
+// EstimateFee returns minimum fee of transaction under protocol parameters of epoch.
+// Use EstimateCurrentFee for parameters of current epoch.
+func (c *Client) EstimateFee(
+	ctx context.Context,
+	epoch EpochNo,
+	in FeeInput,
+	opts *RequestOptions,
+) (*FeeEstimate, error) {
+	if epoch == 0 {
+		// GetEpochParams omits zero epoch and returns all epochs.
+		opts = c.requestOptions(opts)
+		opts.QuerySet("_epoch_no", epoch.String())
+	}
+	res, err := c.GetEpochParams(ctx, epoch, opts)
+	if err != nil {
+		return nil, err
+	}
+	params, err := firstItem(res.Data, "epoch params %s", epoch)
+	if err != nil {
+		return nil, err
+	}
+	return params.EstimateFee(in)
+}
+
+// EstimateCurrentFee returns minimum fee of transaction under protocol parameters
+// of current epoch resolved from chain tip.
+func (c *Client) EstimateCurrentFee(
+	ctx context.Context,
+	in FeeInput,
+	opts *RequestOptions,
+) (*FeeEstimate, error) {
+	tip, err := c.GetTip(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.EstimateFee(ctx, tip.Data.EpochNo, in, opts)
+}
+
+// EstimateFee returns minimum fee of transaction under the protocol parameters.
+func (p *EpochParams) EstimateFee(in FeeInput) (*FeeEstimate, error) {
+	size := in.Size
+	if size == 0 {
+		if in.Tx == nil || len(in.Tx.CborHex) == 0 {
+			return nil, fmt.Errorf("%w: transaction or its size is required", ErrFee)
+		}
+		b, err := hex.DecodeString(in.Tx.CborHex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrFee, err.Error())
+		}
+		size = uint64(len(b))
+	}
+
+	est := &FeeEstimate{
+		EpochNo:       p.EpochNo,
+		Size:          size,
+		RefScriptSize: in.RefScriptSize,
+	}
+	for _, r := range in.Redeemers {
+		est.ExUnits.Mem += r.Mem
+		est.ExUnits.Steps += r.Steps
+	}
+
+	est.SizeFee = p.MinFeeA.Mul(decimalFromUint64(size)).Add(p.MinFeeB)
+	est.ExUnitsFee = p.PriceMem.Mul(decimalFromUint64(est.ExUnits.Mem)).
+		Add(p.PriceStep.Mul(decimalFromUint64(est.ExUnits.Steps))).
+		Ceil()
+	est.RefScriptFee = RefScriptFee(p.MinFeeRefScriptCostPerByte, in.RefScriptSize)
+	est.MinFee = est.SizeFee.Add(est.ExUnitsFee).Add(est.RefScriptFee)
+
+	est.SizeHeadroom = headroom(uint64(p.MaxTxSize), size)
+	est.MemHeadroom = headroom(uint64(p.MaxTxExMem), est.ExUnits.Mem)
+	est.StepsHeadroom = headroom(uint64(p.MaxTxExSteps), est.ExUnits.Steps)
+	return est, nil
+}
+
+// Fits reports whether transaction is within per transaction
+// size and execution unit limits.
+func (e *FeeEstimate) Fits() bool {
+	return e.SizeHeadroom >= 0 && e.MemHeadroom >= 0 && e.StepsHeadroom >= 0
+}
+
+// RefScriptFee returns fee of reference scripts of given total size.
+// Price per byte grows by factor of 1.2 for every 25KiB tier
+// and total is rounded down.
+func RefScriptFee(costPerByte decimal.Decimal, size uint64) decimal.Decimal {
+	var (
+		fee   = decimal.Zero
+		price = costPerByte
+		tier  = decimal.NewFromInt(refScriptTierSize)
+	)
+	for size >= refScriptTierSize {
+		fee = fee.Add(tier.Mul(price))
+		price = price.Mul(refScriptTierMultiplier)
+		size -= refScriptTierSize
+	}
+	return fee.Add(decimalFromUint64(size).Mul(price)).Floor()
+}
+
+// decimalFromUint64 converts v to decimal without overflowing int64.
+func decimalFromUint64(v uint64) decimal.Decimal {
+	return decimal.NewFromBigInt(new(big.Int).SetUint64(v), 0)
+}
+
+// headroom returns limit - used saturated to int64 range.
+func headroom(limit, used uint64) int64 {
+	if used > limit {
+		if used-limit > math.MaxInt64 {
+			return math.MinInt64
+		}
+		return -int64(used - limit)
+	}
+	if limit-used > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(limit - used)
+}
+
+// ReferenceScriptSize returns total size of reference scripts of UTxOs.
+// UTxOs must be fetched with extended flag so that reference scripts are included.
+func ReferenceScriptSize(utxos ...UTxO) uint64 {
+	var total uint64
+	for _, u := range utxos {
+		script, ok := u.ReferenceScript.(map[string]any)
+		if !ok {
+			continue
+		}
+		if size, ok := script["size"].(float64); ok && size > 0 {
+			total += uint64(size)
+		}
+	}
+	return total
+}
+
+// ExUnits returns execution units of evaluated redeemer.
+func (e OgmiosEvaluation) ExUnits() ExUnits {
+	return ExUnits{Mem: e.Budget.Memory, Steps: e.Budget.CPU}
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// mainnetFeeParams returns fee related mainnet protocol parameters.
+func mainnetFeeParams() *EpochParams {
+	return &EpochParams{
+		EpochNo:                    500,
+		MinFeeA:                    decimal.NewFromInt(44),
+		MinFeeB:                    decimal.NewFromInt(155381),
+		PriceMem:                   decimal.RequireFromString("0.0577"),
+		PriceStep:                  decimal.RequireFromString("0.0000721"),
+		MinFeeRefScriptCostPerByte: decimal.NewFromInt(15),
+		MaxTxSize:                  16384,
+		MaxTxExMem:                 14000000,
+		MaxTxExSteps:               10000000000,
+	}
+}
+
+func TestRefScriptFee(t *testing.T) {
+	tests := []struct {
+		size uint64
+		want string
+	}{
+		{size: 0, want: "0"},
+		{size: 1, want: "15"},
+		{size: 25599, want: "383985"},
+		{size: 25600, want: "384000"},
+		{size: 51210, want: "845016"},
+		{size: 80000, want: "1480704"},
+	}
+	cost := mainnetFeeParams().MinFeeRefScriptCostPerByte
+	for _, tt := range tests {
+		if got := RefScriptFee(cost, tt.size); got.String() != tt.want {
+			t.Errorf("size %d: expected %s got %s", tt.size, tt.want, got)
+		}
+	}
+}
+
+func TestEstimateFee(t *testing.T) {
+	tests := []struct {
+		name       string
+		in         FeeInput
+		sizeFee    string
+		exUnitsFee string
+		refFee     string
+		minFee     string
+		fits       bool
+	}{
+		{
+			name:    "size only",
+			in:      FeeInput{Size: 300},
+			sizeFee: "168581", exUnitsFee: "0", refFee: "0", minFee: "168581",
+			fits: true,
+		},
+		{
+			name: "tx cbor",
+			in:   FeeInput{Tx: &TxBodyJSON{CborHex: "84a0a0f5f6"}},
+			// 5 bytes
+			sizeFee: "155601", exUnitsFee: "0", refFee: "0", minFee: "155601",
+			fits: true,
+		},
+		{
+			name: "redeemers and ref script 25600",
+			in: FeeInput{
+				Size:          500,
+				Redeemers:     []ExUnits{{Mem: 400000, Steps: 200000000}, {Mem: 600000, Steps: 300000000}},
+				RefScriptSize: 25600,
+			},
+			sizeFee: "177381", exUnitsFee: "93750", refFee: "384000", minFee: "655131",
+			fits: true,
+		},
+		{
+			name: "redeemers and ref script 30000",
+			in: FeeInput{
+				Size:          500,
+				Redeemers:     []ExUnits{{Mem: 1000000, Steps: 500000000}},
+				RefScriptSize: 30000,
+			},
+			sizeFee: "177381", exUnitsFee: "93750", refFee: "463200", minFee: "734331",
+			fits: true,
+		},
+		{
+			name:    "ref script 80000",
+			in:      FeeInput{Size: 500, RefScriptSize: 80000},
+			sizeFee: "177381", exUnitsFee: "0", refFee: "1480704", minFee: "1658085",
+			fits: true,
+		},
+		{
+			name:    "ex units rounded up",
+			in:      FeeInput{Size: 500, Redeemers: []ExUnits{{Mem: 1, Steps: 1}}},
+			sizeFee: "177381", exUnitsFee: "1", refFee: "0", minFee: "177382",
+			fits: true,
+		},
+		{
+			name:    "above max int64",
+			in:      FeeInput{Size: math.MaxUint64, Redeemers: []ExUnits{{Mem: math.MaxUint64}}},
+			sizeFee: "811656739243220426441", exUnitsFee: "1064377133053041129",
+			refFee: "0", minFee: "812721116376273467570",
+		},
+	}
+	params := mainnetFeeParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			est, err := params.EstimateFee(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range []struct {
+				name string
+				got  decimal.Decimal
+				want string
+			}{
+				{"size fee", est.SizeFee, tt.sizeFee},
+				{"ex units fee", est.ExUnitsFee, tt.exUnitsFee},
+				{"ref script fee", est.RefScriptFee, tt.refFee},
+				{"min fee", est.MinFee, tt.minFee},
+			} {
+				if f.got.String() != f.want {
+					t.Errorf("%s: expected %s got %s", f.name, f.want, f.got)
+				}
+			}
+			if est.Fits() != tt.fits {
+				t.Errorf("expected fits %t got %t", tt.fits, est.Fits())
+			}
+		})
+	}
+}
+
+func TestEstimateFeeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		in   FeeInput
+	}{
+		{name: "empty"},
+		{name: "empty tx", in: FeeInput{Tx: &TxBodyJSON{}}},
+		{name: "invalid cbor hex", in: FeeInput{Tx: &TxBodyJSON{CborHex: "zz"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := mainnetFeeParams().EstimateFee(tt.in); !errors.Is(err, ErrFee) {
+				t.Errorf("expected ErrFee got %v", err)
+			}
+		})
+	}
+}
+
+func TestClientEstimateFee(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		epochs []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tip":
+			_, _ = w.Write([]byte(`[{"epoch_no":500,"abs_slot":130636900}]`))
+		case "/api/v1/epoch_params":
+			epoch := r.URL.Query().Get("_epoch_no")
+			mu.Lock()
+			epochs = append(epochs, epoch)
+			mu.Unlock()
+			fmt.Fprintf(w, `[{"epoch_no":%s,"min_fee_a":44,"min_fee_b":155381,`+
+				`"price_mem":0.0577,"price_step":0.0000721,"min_fee_ref_script_cost_per_byte":15,`+
+				`"max_tx_size":16384,"max_tx_ex_mem":14000000,"max_tx_ex_steps":10000000000}]`, epoch)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(BaseURL(srv.URL + "/api/v1/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	in := FeeInput{Size: 500, Redeemers: []ExUnits{{Mem: 1000000, Steps: 500000000}}, RefScriptSize: 30000}
+
+	est, err := c.EstimateCurrentFee(ctx, in, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if est.EpochNo != 500 || est.MinFee.String() != "734331" {
+		t.Errorf("expected fee 734331 of epoch 500 got %s of epoch %d", est.MinFee, est.EpochNo)
+	}
+
+	opts := c.NewRequestOptions()
+	for _, epoch := range []EpochNo{0, 208} {
+		est, err := c.EstimateFee(ctx, epoch, in, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if est.EpochNo != epoch {
+			t.Errorf("expected params of epoch %d got %d", epoch, est.EpochNo)
+		}
+	}
+	if opts.query.Has("_epoch_no") {
+		t.Error("request options of the caller are modified")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"500", "0", "208"}; fmt.Sprint(epochs) != fmt.Sprint(want) {
+		t.Errorf("expected requested epochs %v got %v", want, epochs)
+	}
+}
@@ -0,0 +1,908 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// DRepID defines type for _drep_id (bech32 encoded).
+	DRepID string
+
+	// ProposalID defines type for _proposal_id (CIP-129 bech32 encoded).
+	ProposalID string
+
+	// Vote cast by voter on governance action.
+	Vote string
+
+	// VoterRole of the voter casting vote on governance action.
+	VoterRole string
+
+	// DRepListItem defines model for `/drep_list`.
+	DRepListItem struct {
+		// DRepID DRep ID in CIP-129 bech32 format.
+		DRepID DRepID `json:"drep_id"`
+		// Hex DRep ID in hex format.
+		Hex string `json:"hex"`
+		// HasScript flag which shows if this DRep credentials are a script hash.
+		HasScript bool `json:"has_script"`
+		// Registered flag to show if the DRep is currently registered.
+		Registered bool `json:"registered"`
+	}
+
+	// DRepInfo defines model for `/drep_info`.
+	DRepInfo struct {
+		DRepListItem
+		// Deposit DRep's registration deposit in lovelace (nullable).
+		Deposit decimal.Decimal `json:"deposit"`
+		// Active reports whether the DRep is active (i.e. not expired).
+		Active bool `json:"active"`
+		// ExpiresEpochNo after which DRep will become inactive (nullable).
+		ExpiresEpochNo *EpochNo `json:"expires_epoch_no"`
+		// Amount is total voting power of the DRep in lovelace.
+		Amount decimal.Decimal `json:"amount"`
+		// MetaURL DRep metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash DRep metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+	}
+
+	// DRepMetadata defines model for `/drep_metadata`.
+	DRepMetadata struct {
+		// DRepID DRep ID in CIP-129 bech32 format.
+		DRepID DRepID `json:"drep_id"`
+		// Hex DRep ID in hex format.
+		Hex string `json:"hex"`
+		// HasScript flag which shows if this DRep credentials are a script hash.
+		HasScript bool `json:"has_script"`
+		// MetaURL DRep metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash DRep metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+		// MetaJSON DRep metadata content (nullable).
+		MetaJSON *json.RawMessage `json:"meta_json,omitempty"`
+		// Bytes of the raw metadata content.
+		Bytes string `json:"bytes"`
+		// Warning while fetching or validating metadata (nullable).
+		Warning string `json:"warning"`
+		// Language of the metadata.
+		Language string `json:"language"`
+		// Comment attached to the metadata.
+		Comment string `json:"comment"`
+		// IsValid flag which shows if metadata content is valid.
+		IsValid *bool `json:"is_valid"`
+	}
+
+	// DRepUpdate defines model for `/drep_updates`.
+	DRepUpdate struct {
+		// DRepID DRep ID in CIP-129 bech32 format.
+		DRepID DRepID `json:"drep_id"`
+		// Hex DRep ID in hex format.
+		Hex string `json:"hex"`
+		// UpdateTxHash of the transaction which included the update.
+		UpdateTxHash TxHash `json:"update_tx_hash"`
+		// CertIndex the index of this certificate within the transaction.
+		CertIndex int `json:"cert_index"`
+		// BlockTime of the block which included the update.
+		BlockTime Timestamp `json:"block_time"`
+		// Action type (registration | deregistration | updated).
+		Action string `json:"action"`
+		// Deposit DRep's registration deposit in lovelace (nullable).
+		Deposit decimal.Decimal `json:"deposit"`
+		// MetaURL DRep metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash DRep metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+		// MetaJSON DRep metadata content (nullable).
+		MetaJSON *json.RawMessage `json:"meta_json,omitempty"`
+	}
+
+	// GovernanceVote defines model for votes cast by DReps, SPOs or committee members
+	// returned by `/drep_votes`, `/pool_votes` and `/committee_votes`.
+	GovernanceVote struct {
+		// ProposalID of the governance action (CIP-129 bech32 format).
+		ProposalID ProposalID `json:"proposal_id"`
+		// ProposalTxHash of the transaction which submitted the proposal.
+		ProposalTxHash TxHash `json:"proposal_tx_hash"`
+		// ProposalIndex of the proposal within the transaction.
+		ProposalIndex uint32 `json:"proposal_index"`
+		// VoteTxHash of the transaction which cast the vote.
+		VoteTxHash TxHash `json:"vote_tx_hash"`
+		// BlockTime of the block which included the vote.
+		BlockTime Timestamp `json:"block_time"`
+		// Vote cast (Yes | No | Abstain).
+		Vote Vote `json:"vote"`
+		// MetaURL vote metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash vote metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+	}
+
+	// DRepDelegator defines model for `/drep_delegators`.
+	DRepDelegator struct {
+		// StakeAddress of the delegator (bech32 format).
+		StakeAddress Address `json:"stake_address"`
+		// StakeAddressHex of the delegator.
+		StakeAddressHex string `json:"stake_address_hex"`
+		// ScriptHash of the stake address (nullable).
+		ScriptHash ScriptHash `json:"script_hash"`
+		// EpochNo when vote delegation was made.
+		EpochNo EpochNo `json:"epoch_no"`
+		// Amount of the delegated stake in lovelace.
+		Amount decimal.Decimal `json:"amount"`
+	}
+
+	// ProposalWithdrawal treasury withdrawal requested by the proposal.
+	ProposalWithdrawal struct {
+		StakeAddress Address         `json:"stake_address"`
+		Amount       decimal.Decimal `json:"amount"`
+	}
+
+	// Proposal defines model for `/proposal_list`.
+	Proposal struct {
+		// BlockTime of the block which included the proposal.
+		BlockTime Timestamp `json:"block_time"`
+		// ProposalID of the governance action (CIP-129 bech32 format).
+		ProposalID ProposalID `json:"proposal_id"`
+		// ProposalTxHash of the transaction which submitted the proposal.
+		ProposalTxHash TxHash `json:"proposal_tx_hash"`
+		// ProposalIndex of the proposal within the transaction.
+		ProposalIndex uint32 `json:"proposal_index"`
+		// ProposalType (ParameterChange | HardForkInitiation | TreasuryWithdrawals |
+		// NoConfidence | NewCommittee | NewConstitution | InfoAction).
+		ProposalType string `json:"proposal_type"`
+		// ProposalDescription of the governance action.
+		ProposalDescription *json.RawMessage `json:"proposal_description,omitempty"`
+		// Deposit of the proposal in lovelace.
+		Deposit decimal.Decimal `json:"deposit"`
+		// ReturnAddress where deposit will be returned (bech32 stake address).
+		ReturnAddress Address `json:"return_address"`
+		// ProposedEpoch when proposal was submitted.
+		ProposedEpoch EpochNo `json:"proposed_epoch"`
+		// RatifiedEpoch when proposal was ratified (nullable).
+		RatifiedEpoch *EpochNo `json:"ratified_epoch"`
+		// EnactedEpoch when proposal was enacted (nullable).
+		EnactedEpoch *EpochNo `json:"enacted_epoch"`
+		// DroppedEpoch when proposal was dropped (nullable).
+		DroppedEpoch *EpochNo `json:"dropped_epoch"`
+		// ExpiredEpoch when proposal expired (nullable).
+		ExpiredEpoch *EpochNo `json:"expired_epoch"`
+		// Expiration epoch after which proposal can not be voted on (nullable).
+		Expiration *EpochNo `json:"expiration"`
+		// MetaURL proposal metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash proposal metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+		// MetaJSON proposal metadata content (nullable).
+		MetaJSON *json.RawMessage `json:"meta_json,omitempty"`
+		// MetaComment attached to the metadata (nullable).
+		MetaComment string `json:"meta_comment"`
+		// MetaLanguage of the metadata (nullable).
+		MetaLanguage string `json:"meta_language"`
+		// MetaIsValid flag which shows if metadata content is valid (nullable).
+		MetaIsValid *bool `json:"meta_is_valid"`
+		// Withdrawal requested by TreasuryWithdrawals proposal (nullable).
+		Withdrawal *ProposalWithdrawal `json:"withdrawal,omitempty"`
+		// ParamProposal proposed protocol parameter changes (nullable).
+		ParamProposal *json.RawMessage `json:"param_proposal,omitempty"`
+	}
+
+	// ProposalVote defines model for `/proposal_votes`.
+	ProposalVote struct {
+		// BlockTime of the block which included the vote.
+		BlockTime Timestamp `json:"block_time"`
+		// VoterRole (ConstitutionalCommittee | DRep | SPO).
+		VoterRole VoterRole `json:"voter_role"`
+		// VoterID of the voter (bech32 format).
+		VoterID string `json:"voter_id"`
+		// VoterHex of the voter.
+		VoterHex string `json:"voter_hex"`
+		// VoterHasScript flag which shows if voter credentials are a script hash.
+		VoterHasScript bool `json:"voter_has_script"`
+		// Vote cast (Yes | No | Abstain).
+		Vote Vote `json:"vote"`
+		// MetaURL vote metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash vote metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+	}
+
+	// VoteListItem defines model for `/vote_list`.
+	VoteListItem struct {
+		// VoteTxHash of the transaction which cast the vote.
+		VoteTxHash TxHash `json:"vote_tx_hash"`
+		// VoterRole (ConstitutionalCommittee | DRep | SPO).
+		VoterRole VoterRole `json:"voter_role"`
+		// VoterID of the voter (bech32 format).
+		VoterID string `json:"voter_id"`
+		// ProposalID of the governance action (CIP-129 bech32 format).
+		ProposalID ProposalID `json:"proposal_id"`
+		// ProposalTxHash of the transaction which submitted the proposal.
+		ProposalTxHash TxHash `json:"proposal_tx_hash"`
+		// ProposalIndex of the proposal within the transaction.
+		ProposalIndex uint32 `json:"proposal_index"`
+		// ProposalType of the governance action.
+		ProposalType string `json:"proposal_type"`
+		// EpochNo when vote was cast.
+		EpochNo EpochNo `json:"epoch_no"`
+		// BlockHeight of the block which included the vote.
+		BlockHeight BlockNo `json:"block_height"`
+		// BlockTime of the block which included the vote.
+		BlockTime Timestamp `json:"block_time"`
+		// Vote cast (Yes | No | Abstain).
+		Vote Vote `json:"vote"`
+		// MetaURL vote metadata URL (nullable).
+		MetaURL string `json:"meta_url"`
+		// MetaHash vote metadata hash (nullable).
+		MetaHash string `json:"meta_hash"`
+		// MetaJSON vote metadata content (nullable).
+		MetaJSON *json.RawMessage `json:"meta_json,omitempty"`
+	}
+
+	// CommitteeMember of the constitutional committee.
+	CommitteeMember struct {
+		// Status of the member (authorized | not_authorized | resigned).
+		Status string `json:"status"`
+		// CCHotID member hot credential (bech32 format, nullable).
+		CCHotID string `json:"cc_hot_id"`
+		// CCColdID member cold credential (bech32 format).
+		CCColdID string `json:"cc_cold_id"`
+		// CCHotHex member hot credential (hex, nullable).
+		CCHotHex string `json:"cc_hot_hex"`
+		// CCColdHex member cold credential (hex).
+		CCColdHex string `json:"cc_cold_hex"`
+		// ExpirationEpoch of the membership.
+		ExpirationEpoch EpochNo `json:"expiration_epoch"`
+		// CCHotHasScript flag which shows if hot credential is a script hash.
+		CCHotHasScript bool `json:"cc_hot_has_script"`
+		// CCColdHasScript flag which shows if cold credential is a script hash.
+		CCColdHasScript bool `json:"cc_cold_has_script"`
+	}
+
+	// CommitteeInfo defines model for `/committee_info`.
+	CommitteeInfo struct {
+		// ProposalID of the governance action which formed the committee (nullable).
+		ProposalID ProposalID `json:"proposal_id"`
+		// ProposalTxHash of the transaction which formed the committee (nullable).
+		ProposalTxHash TxHash `json:"proposal_tx_hash"`
+		// ProposalIndex of the proposal within the transaction (nullable).
+		ProposalIndex *uint32 `json:"proposal_index"`
+		// QuorumNumerator of the committee quorum threshold.
+		QuorumNumerator uint64 `json:"quorum_numerator"`
+		// QuorumDenominator of the committee quorum threshold.
+		QuorumDenominator uint64 `json:"quorum_denominator"`
+		// Members of the committee.
+		Members []CommitteeMember `json:"members"`
+	}
+
+	// DRepVotingPower entry of DRep voting power history.
+	DRepVotingPower struct {
+		// DRepID DRep ID in CIP-129 bech32 format.
+		DRepID DRepID `json:"drep_id"`
+		// EpochNo of the voting power snapshot.
+		EpochNo EpochNo `json:"epoch_no"`
+		// Amount of the voting power in lovelace.
+		Amount decimal.Decimal `json:"amount"`
+	}
+
+	// VotingPowerEntry is ranked entry of the VotingPowerTable.
+	VotingPowerEntry struct {
+		// Rank of the voter within epoch, 1 being largest voting power.
+		Rank int
+		// VoterID pool ID or DRep ID (bech32 format).
+		VoterID string
+		// Ticker of the pool, empty for DReps.
+		Ticker string
+		// Name of the pool or DRep given name from metadata.
+		Name string
+		// Active is false for retired pools and inactive DReps.
+		Active bool
+		// Amount of the voting power in lovelace.
+		Amount decimal.Decimal
+		// Share of the total voting power within epoch (0-1).
+		Share decimal.Decimal
+	}
+
+	// VotingPowerTable ranked voting power of pools or DReps in single epoch.
+	VotingPowerTable struct {
+		EpochNo EpochNo
+		Role    VoterRole
+		// Total voting power within epoch in lovelace.
+		Total   decimal.Decimal
+		Entries []VotingPowerEntry
+	}
+
+	// DRepListResponse represents response from `/drep_list` endpoint.
+	DRepListResponse = ListResponse[DRepListItem]
+	// DRepInfoResponse represents response from `/drep_info` endpoint.
+	DRepInfoResponse = ListResponse[DRepInfo]
+	// DRepMetadataResponse represents response from `/drep_metadata` endpoint.
+	DRepMetadataResponse = ListResponse[DRepMetadata]
+	// DRepUpdatesResponse represents response from `/drep_updates` endpoint.
+	DRepUpdatesResponse = ListResponse[DRepUpdate]
+	// DRepVotesResponse represents response from `/drep_votes` endpoint.
+	DRepVotesResponse = ListResponse[GovernanceVote]
+	// DRepDelegatorsResponse represents response from `/drep_delegators` endpoint.
+	DRepDelegatorsResponse = ListResponse[DRepDelegator]
+	// ProposalListResponse represents response from `/proposal_list` endpoint.
+	ProposalListResponse = ListResponse[Proposal]
+	// ProposalVotesResponse represents response from `/proposal_votes` endpoint.
+	ProposalVotesResponse = ListResponse[ProposalVote]
+	// VoteListResponse represents response from `/vote_list` endpoint.
+	VoteListResponse = ListResponse[VoteListItem]
+	// CommitteeInfoResponse represents response from `/committee_info` endpoint.
+	CommitteeInfoResponse = Response[*CommitteeInfo]
+	// CommitteeVotesResponse represents response from `/committee_votes` endpoint.
+	CommitteeVotesResponse = ListResponse[GovernanceVote]
+	// DRepVotingPowerHistoryResponse represents response from `/drep_voting_power_history` endpoint.
+	DRepVotingPowerHistoryResponse = ListResponse[DRepVotingPower]
+)
+
+// Votes which can be cast on governance action.
+const (
+	VoteYes     Vote = "Yes"
+	VoteNo      Vote = "No"
+	VoteAbstain Vote = "Abstain"
+)
+
+// Predefined DReps which voting power can be delegated to.
+const (
+	DRepAlwaysAbstain      DRepID = "drep_always_abstain"
+	DRepAlwaysNoConfidence DRepID = "drep_always_no_confidence"
+)
+
+// votingPowerInfoBatch is max number of ids looked up
+// with single request when building voting power tables.
+const votingPowerInfoBatch = 100
+
+// Roles of the voters on governance actions.
+const (
+	VoterRoleCommittee VoterRole = "ConstitutionalCommittee"
+	VoterRoleDRep      VoterRole = "DRep"
+	VoterRoleSPO       VoterRole = "SPO"
+)
+
+// String returns DRepID as string.
+func (v DRepID) String() string {
+	return string(v)
+}
+
+// String returns ProposalID as string.
+func (v ProposalID) String() string {
+	return string(v)
+}
+
+// String returns Vote as string.
+func (v Vote) String() string {
+	return string(v)
+}
+
+// String returns VoterRole as string.
+func (v VoterRole) String() string {
+	return string(v)
+}
+
+// GetDRepList returns the list of all DReps registered on chain.
+func (c *Client) GetDRepList(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *DRepListResponse, err error) {
+	res = &DRepListResponse{}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/drep_list", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepInfo returns summary information about given DReps.
+func (c *Client) GetDRepInfo(
+	ctx context.Context,
+	ids []DRepID,
+	opts *RequestOptions,
+) (res *DRepInfoResponse, err error) {
+	res = &DRepInfoResponse{}
+	if len(ids) == 0 {
+		err = ErrNoDRepID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_drep_ids", ids)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/drep_info", drepIdsPL(ids), opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepMetadata returns metadata for given DReps.
+func (c *Client) GetDRepMetadata(
+	ctx context.Context,
+	ids []DRepID,
+	opts *RequestOptions,
+) (res *DRepMetadataResponse, err error) {
+	res = &DRepMetadataResponse{}
+	if len(ids) == 0 {
+		err = ErrNoDRepID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_drep_ids", ids)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/drep_metadata", drepIdsPL(ids), opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepUpdates returns list of updates for given DRep
+// or all DReps updates when id is empty.
+func (c *Client) GetDRepUpdates(
+	ctx context.Context,
+	id DRepID,
+	opts *RequestOptions,
+) (res *DRepUpdatesResponse, err error) {
+	res = &DRepUpdatesResponse{}
+
+	if err = c.validate(func(v *validator) {
+		if len(id) > 0 {
+			v.value("_drep_id", id)
+		}
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	if len(id) > 0 {
+		opts.QuerySet("_drep_id", id.String())
+	}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/drep_updates", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepVotes returns list of all votes cast by given DRep.
+func (c *Client) GetDRepVotes(
+	ctx context.Context,
+	id DRepID,
+	opts *RequestOptions,
+) (res *DRepVotesResponse, err error) {
+	res = &DRepVotesResponse{}
+	if len(id) == 0 {
+		err = ErrNoDRepID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		v.value("_drep_id", id)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	opts.QuerySet("_drep_id", id.String())
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/drep_votes", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepDelegators returns list of all delegators to given DRep.
+func (c *Client) GetDRepDelegators(
+	ctx context.Context,
+	id DRepID,
+	opts *RequestOptions,
+) (res *DRepDelegatorsResponse, err error) {
+	res = &DRepDelegatorsResponse{}
+	if len(id) == 0 {
+		err = ErrNoDRepID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		v.value("_drep_id", id)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	opts.QuerySet("_drep_id", id.String())
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/drep_delegators", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetProposalList returns list of all governance proposals.
+func (c *Client) GetProposalList(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *ProposalListResponse, err error) {
+	res = &ProposalListResponse{}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/proposal_list", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetProposalVotes returns list of all votes cast on given proposal.
+func (c *Client) GetProposalVotes(
+	ctx context.Context,
+	id ProposalID,
+	opts *RequestOptions,
+) (res *ProposalVotesResponse, err error) {
+	res = &ProposalVotesResponse{}
+	if len(id) == 0 {
+		err = ErrNoProposalID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		v.value("_proposal_id", id)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	opts.QuerySet("_proposal_id", id.String())
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/proposal_votes", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetVoteList returns list of all votes cast on governance proposals.
+func (c *Client) GetVoteList(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *VoteListResponse, err error) {
+	res = &VoteListResponse{}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/vote_list", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetCommitteeInfo returns information about current constitutional committee.
+func (c *Client) GetCommitteeInfo(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *CommitteeInfoResponse, err error) {
+	res = &CommitteeInfoResponse{}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/committee_info", nil, opts)
+	if err != nil {
+		return
+	}
+	info := []CommitteeInfo{}
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &info); err != nil {
+		return
+	}
+	res.Data, err = firstItem(info, "committee info")
+	return
+}
+
+// GetCommitteeVotes returns list of all votes cast by given committee member
+// or all committee members when ccHotID is empty.
+func (c *Client) GetCommitteeVotes(
+	ctx context.Context,
+	ccHotID string,
+	opts *RequestOptions,
+) (res *CommitteeVotesResponse, err error) {
+	res = &CommitteeVotesResponse{}
+
+	if err = c.validate(func(v *validator) {
+		if len(ccHotID) > 0 {
+			v.value("_cc_hot_id", committeeHotID(ccHotID))
+		}
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	if len(ccHotID) > 0 {
+		opts.QuerySet("_cc_hot_id", ccHotID)
+	}
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/committee_votes", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetDRepVotingPowerHistory returns history of voting power of given DRep
+// or all DReps when id is empty, within provided epoch range.
+func (c *Client) GetDRepVotingPowerHistory(
+	ctx context.Context,
+	id DRepID,
+	epochs EpochRange,
+	opts *RequestOptions,
+) (res *DRepVotingPowerHistoryResponse, err error) {
+	res = &DRepVotingPowerHistoryResponse{}
+
+	if err = c.validate(func(v *validator) {
+		if len(id) > 0 {
+			v.value("_drep_id", id)
+		}
+		v.epochs("epoch_no", epochs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
+	if len(id) > 0 {
+		opts.QuerySet("_drep_id", id.String())
+	}
+	epochs.applyQuery(opts)
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/drep_voting_power_history", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolVotingPowerTables returns ranked voting power tables of all pools,
+// one per epoch within provided epoch range. Pools are named using
+// tickers and names from GetPoolInfos.
+// Provided options are used to page through voting power history.
+func (c *Client) GetPoolVotingPowerTables(
+	ctx context.Context,
+	epochs EpochRange,
+	opts *RequestOptions,
+) ([]VotingPowerTable, error) {
+	var history []PoolVotingPower
+	err := c.eachPage(opts, func(opts *RequestOptions) (int, error) {
+		res, err := c.GetPoolVotingPowerHistory(ctx, "", epochs, opts)
+		if err != nil {
+			return 0, err
+		}
+		history = append(history, res.Data...)
+		return len(res.Data), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []PoolID
+	seen := make(map[PoolID]bool)
+	for _, vp := range history {
+		if !seen[vp.PoolID] {
+			seen[vp.PoolID] = true
+			pids = append(pids, vp.PoolID)
+		}
+	}
+	infos := make(map[PoolID]PoolInfo, len(pids))
+	for _, batch := range batches(pids, votingPowerInfoBatch) {
+		res, err := c.GetPoolInfos(ctx, batch, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range res.Data {
+			infos[info.PoolIDBech32] = info
+		}
+	}
+
+	entries := make(map[EpochNo][]VotingPowerEntry)
+	for _, vp := range history {
+		entry := VotingPowerEntry{
+			VoterID: vp.PoolID.String(),
+			Active:  true,
+			Amount:  vp.Amount,
+		}
+		if info, ok := infos[vp.PoolID]; ok {
+			if info.MetaJSON.Ticker != nil {
+				entry.Ticker = *info.MetaJSON.Ticker
+			}
+			if info.MetaJSON.Name != nil {
+				entry.Name = *info.MetaJSON.Name
+			}
+			entry.Active = info.PoolStatus != PoolStatusRetired
+		}
+		entries[vp.EpochNo] = append(entries[vp.EpochNo], entry)
+	}
+	return votingPowerTables(VoterRoleSPO, entries), nil
+}
+
+// GetDRepVotingPowerTables returns ranked voting power tables of all DReps,
+// one per epoch within provided epoch range. DReps are named using
+// given name from DRep metadata and marked active using GetDRepInfo.
+// Provided options are used to page through voting power history.
+func (c *Client) GetDRepVotingPowerTables(
+	ctx context.Context,
+	epochs EpochRange,
+	opts *RequestOptions,
+) ([]VotingPowerTable, error) {
+	var history []DRepVotingPower
+	err := c.eachPage(opts, func(opts *RequestOptions) (int, error) {
+		res, err := c.GetDRepVotingPowerHistory(ctx, "", epochs, opts)
+		if err != nil {
+			return 0, err
+		}
+		history = append(history, res.Data...)
+		return len(res.Data), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []DRepID
+	seen := make(map[DRepID]bool)
+	for _, vp := range history {
+		if vp.DRepID == DRepAlwaysAbstain || vp.DRepID == DRepAlwaysNoConfidence {
+			continue
+		}
+		if !seen[vp.DRepID] {
+			seen[vp.DRepID] = true
+			ids = append(ids, vp.DRepID)
+		}
+	}
+	infos := make(map[DRepID]DRepInfo, len(ids))
+	names := make(map[DRepID]string, len(ids))
+	for _, batch := range batches(ids, votingPowerInfoBatch) {
+		info, err := c.GetDRepInfo(ctx, batch, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range info.Data {
+			infos[i.DRepID] = i
+		}
+		meta, err := c.GetDRepMetadata(ctx, batch, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range meta.Data {
+			names[m.DRepID] = drepGivenName(m.MetaJSON)
+		}
+	}
+
+	entries := make(map[EpochNo][]VotingPowerEntry)
+	for _, vp := range history {
+		entry := VotingPowerEntry{
+			VoterID: vp.DRepID.String(),
+			Name:    names[vp.DRepID],
+			Active:  true,
+			Amount:  vp.Amount,
+		}
+		if info, ok := infos[vp.DRepID]; ok {
+			entry.Active = info.Active
+		}
+		entries[vp.EpochNo] = append(entries[vp.EpochNo], entry)
+	}
+	return votingPowerTables(VoterRoleDRep, entries), nil
+}
+
+// votingPowerTables ranks entries within each epoch
+// and returns tables ordered by epoch.
+func votingPowerTables(role VoterRole, entries map[EpochNo][]VotingPowerEntry) []VotingPowerTable {
+	tables := make([]VotingPowerTable, 0, len(entries))
+	for epoch, list := range entries {
+		sort.Slice(list, func(i, j int) bool {
+			if c := list[i].Amount.Cmp(list[j].Amount); c != 0 {
+				return c > 0
+			}
+			return list[i].VoterID < list[j].VoterID
+		})
+		total := decimal.Zero
+		for _, e := range list {
+			total = total.Add(e.Amount)
+		}
+		for i := range list {
+			list[i].Rank = i + 1
+			if total.IsPositive() {
+				list[i].Share = list[i].Amount.Div(total)
+			}
+		}
+		tables = append(tables, VotingPowerTable{
+			EpochNo: epoch,
+			Role:    role,
+			Total:   total,
+			Entries: list,
+		})
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].EpochNo < tables[j].EpochNo
+	})
+	return tables
+}
+
+// drepGivenName returns givenName from CIP-119 DRep metadata.
+func drepGivenName(meta *json.RawMessage) string {
+	if meta == nil {
+		return ""
+	}
+	var doc struct {
+		Body struct {
+			GivenName json.RawMessage `json:"givenName"`
+		} `json:"body"`
+	}
+	if err := json.Unmarshal(*meta, &doc); err != nil || len(doc.Body.GivenName) == 0 {
+		return ""
+	}
+	var name string
+	if err := json.Unmarshal(doc.Body.GivenName, &name); err == nil {
+		return name
+	}
+	// JSON-LD value object {"@value": "name"}
+	var value struct {
+		Value string `json:"@value"`
+	}
+	_ = json.Unmarshal(doc.Body.GivenName, &value)
+	return value.Value
+}
+
+// batches splits items into batches of max size n.
+func batches[T any](items []T, n int) [][]T {
+	var res [][]T
+	for len(items) > n {
+		res = append(res, items[:n])
+		items = items[n:]
+	}
+	if len(items) > 0 {
+		res = append(res, items)
+	}
+	return res
+}
+
+func drepIdsPL(ids []DRepID) io.Reader {
+	var payload = struct {
+		DRepIDs []DRepID `json:"_drep_ids"`
+	}{ids}
+	rpipe, w := io.Pipe()
+	go func() {
+		_ = json.NewEncoder(w).Encode(payload)
+		defer w.Close()
+	}()
+	return rpipe
+}
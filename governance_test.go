@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestVotingPowerTables(t *testing.T) {
+	entry := func(id string, amount int64) VotingPowerEntry {
+		return VotingPowerEntry{VoterID: id, Amount: decimal.NewFromInt(amount)}
+	}
+	tables := votingPowerTables(VoterRoleDRep, map[EpochNo][]VotingPowerEntry{
+		512: {entry("drep1c", 20), entry("drep1a", 30), entry("drep1b", 50)},
+		510: {entry("drep1z", 0), entry("drep1y", 0)},
+		511: {entry("drep1c", 1), entry("drep1b", 1), entry("drep1a", 1)},
+	})
+
+	if len(tables) != 3 {
+		t.Fatalf("expected 3 tables got %d", len(tables))
+	}
+	for i, epoch := range []EpochNo{510, 511, 512} {
+		if tables[i].EpochNo != epoch || tables[i].Role != VoterRoleDRep {
+			t.Errorf("table %d: expected epoch %d got %d (%s)", i, epoch, tables[i].EpochNo, tables[i].Role)
+		}
+	}
+
+	tests := []struct {
+		table  VotingPowerTable
+		ids    []string
+		shares []string
+		total  string
+	}{
+		// zero total, shares are not computed, ties broken by id.
+		{table: tables[0], ids: []string{"drep1y", "drep1z"}, shares: []string{"0", "0"}, total: "0"},
+		// equal amounts ordered by id.
+		{table: tables[1], ids: []string{"drep1a", "drep1b", "drep1c"}, total: "3"},
+		{table: tables[2], ids: []string{"drep1b", "drep1a", "drep1c"}, shares: []string{"0.5", "0.3", "0.2"}, total: "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.table.EpochNo.String(), func(t *testing.T) {
+			if tt.table.Total.String() != tt.total {
+				t.Errorf("expected total %s got %s", tt.total, tt.table.Total)
+			}
+			sum := decimal.Zero
+			for i, e := range tt.table.Entries {
+				if e.Rank != i+1 || e.VoterID != tt.ids[i] {
+					t.Errorf("rank %d: expected %s got %s ranked %d", i+1, tt.ids[i], e.VoterID, e.Rank)
+				}
+				if tt.shares != nil && e.Share.String() != tt.shares[i] {
+					t.Errorf("%s: expected share %s got %s", e.VoterID, tt.shares[i], e.Share)
+				}
+				sum = sum.Add(e.Share)
+			}
+			if tt.table.Total.IsZero() {
+				if !sum.IsZero() {
+					t.Errorf("expected zero shares got %s", sum)
+				}
+				return
+			}
+			// shares of thirds are rounded to division precision.
+			if diff := sum.Sub(decimal.NewFromInt(1)).Abs(); diff.GreaterThan(decimal.New(1, -15)) {
+				t.Errorf("expected shares to sum to 1 got %s", sum)
+			}
+		})
+	}
+
+	if tables := votingPowerTables(VoterRoleSPO, nil); len(tables) != 0 {
+		t.Errorf("expected no tables got %d", len(tables))
+	}
+}
+
+func TestDRepGivenName(t *testing.T) {
+	tests := []struct {
+		name string
+		meta string
+		want string
+	}{
+		{name: "string", meta: `{"body":{"givenName":"Alice"}}`, want: "Alice"},
+		{name: "json-ld value", meta: `{"@context":{},"body":{"givenName":{"@value":"Bob"}}}`, want: "Bob"},
+		{name: "missing", meta: `{"body":{}}`},
+		{name: "number", meta: `{"body":{"givenName":1}}`},
+		{name: "invalid", meta: `[]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := json.RawMessage(tt.meta)
+			if got := drepGivenName(&raw); got != tt.want {
+				t.Errorf("expected %q got %q", tt.want, got)
+			}
+		})
+	}
+	if got := drepGivenName(nil); got != "" {
+		t.Errorf("expected empty name of nil metadata got %q", got)
+	}
+}
+
+func TestBatches(t *testing.T) {
+	items := func(n int) []int {
+		list := make([]int, n)
+		for i := range list {
+			list[i] = i
+		}
+		return list
+	}
+	tests := []struct {
+		items int
+		size  int
+		want  []int
+	}{
+		{items: 0, size: 3, want: nil},
+		{items: 1, size: 3, want: []int{1}},
+		{items: 3, size: 3, want: []int{3}},
+		{items: 4, size: 3, want: []int{3, 1}},
+		{items: 6, size: 3, want: []int{3, 3}},
+		{items: 7, size: 3, want: []int{3, 3, 1}},
+		{items: votingPowerInfoBatch, size: votingPowerInfoBatch, want: []int{votingPowerInfoBatch}},
+		{items: votingPowerInfoBatch + 1, size: votingPowerInfoBatch, want: []int{votingPowerInfoBatch, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tt.items, tt.size), func(t *testing.T) {
+			res := batches(items(tt.items), tt.size)
+			if len(res) != len(tt.want) {
+				t.Fatalf("expected %d batches got %d", len(tt.want), len(res))
+			}
+			next := 0
+			for i, b := range res {
+				if len(b) != tt.want[i] {
+					t.Errorf("batch %d: expected %d items got %d", i, tt.want[i], len(b))
+				}
+				for _, v := range b {
+					if v != next {
+						t.Fatalf("expected item %d got %d", next, v)
+					}
+					next++
+				}
+			}
+		})
+	}
+}
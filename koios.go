@@ -11,6 +11,7 @@
 package koios // imports as package "koios"
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -46,7 +47,7 @@ const (
 	// DefaultRateLimit is default rate limit used by api client.
 	DefaultRateLimit int = 10 // https://api.koios.rest/#overview--limits
 	// DefaultOrigin is default origin header used by api client.
-	DefaultOrigin = "https://github.com/cardano-community/koios-go-client/v4"
+	DefaultOrigin = "https://github.com/cardano-community/koios-go-client/v5"
 	// PageSize is default page size used by api client.
 	PageSize       uint = 1000
 	DefaultTimeout      = 30 * time.Second
@@ -61,11 +62,20 @@ var (
 	ErrRateLimitRange           = errors.New("rate limit must be between 1-255 requests per sec")
 	ErrResponseIsNotJSON        = errors.New("got non json response")
 	ErrNoTxHash                 = errors.New("missing transaxtion hash(es)")
+	ErrNoBlockHash              = errors.New("missing block hash(es)")
 	ErrNoDatumHash              = errors.New("missing datum hash(es)")
+	ErrAddress                  = errors.New("invalid address")
+	ErrCredential               = errors.New("invalid credential")
+	ErrNoStakeCredential        = errors.New("address has no stake credential")
 	ErrNoAddress                = errors.New("missing address")
 	ErrNoAddressesProvided      = errors.New("atleast one address required")
 	ErrNoCredentialsProvided    = errors.New("atleast one payment credential required")
+	ErrNoPolicyID               = errors.New("missing policy id")
+	ErrPolicyID                 = errors.New("invalid policy id")
 	ErrNoPoolID                 = errors.New("missing pool id")
+	ErrInvalidPoolID            = errors.New("invalid pool id")
+	ErrNoDRepID                 = errors.New("missing drep id(s)")
+	ErrNoProposalID             = errors.New("missing proposal id")
 	ErrResponse                 = errors.New("response error")
 	ErrSchema                   = errors.New("scheme must be http or https")
 	ErrReqOptsAlreadyUsed       = errors.New("request options can only be used once")
@@ -73,11 +83,31 @@ var (
 	ErrUTxOInputAlreadyUsed     = errors.New("UTxO already used")
 	ErrNoData                   = errors.New("no data")
 	ErrAsset                    = errors.New("asset error")
+	ErrCIP25Metadata            = errors.New("invalid cip25 metadata")
+	ErrMetadata                 = errors.New("invalid metadata")
+	ErrCIP68Metadata            = errors.New("invalid cip68 metadata")
 	ErrHTTPClientNotSet         = errors.New("http.Client not set")
 	ErrClientLocked             = errors.New("client is locked")
 	ErrNoScriptHash             = errors.New("missing script hash(es)")
 	ErrNoUTxORef                = errors.New("missing UTxO reference(s)")
 	ErrAuth                     = errors.New("auth error")
+	ErrURL                      = errors.New("invalid url")
+	ErrOgmios                   = errors.New("ogmios error")
+	ErrTLSConfig                = errors.New("tls config error")
+	ErrTimeConverter            = errors.New("time conversion error")
+	ErrNetworkMismatch          = errors.New("network mismatch")
+	ErrNetworkRouting           = errors.New("network routing failed")
+	ErrValidation               = errors.New("invalid input")
+	ErrTxHash                   = errors.New("invalid transaction hash")
+	ErrBlockHash                = errors.New("invalid block hash")
+	ErrDatumHash                = errors.New("invalid datum hash")
+	ErrScriptHash               = errors.New("invalid script hash")
+	ErrUTxORef                  = errors.New("invalid UTxO reference")
+	ErrEpochNo                  = errors.New("invalid epoch")
+	ErrDRepID                   = errors.New("invalid drep id")
+	ErrProposalID               = errors.New("invalid proposal id")
+	ErrCCHotID                  = errors.New("invalid committee hot id")
+	ErrFee                      = errors.New("fee estimation error")
 
 	// ZeroLovelace is alias decimal.Zero.
 	ZeroLovelace = decimal.Zero.Copy() //nolint: gochecknoglobals
@@ -93,6 +123,9 @@ type (
 	// PaymentCredential type def.
 	PaymentCredential string
 
+	// StakeCredential is hex encoded stake key hash or script hash.
+	StakeCredential string
+
 	// BlockHash defines type for _block_hash.
 	BlockHash string
 
@@ -102,6 +135,15 @@ type (
 	// PoolID type def.
 	PoolID string
 
+	// PoolIDError is returned when pool id can not be decoded.
+	// It matches ErrInvalidPoolID with errors.Is.
+	PoolIDError struct {
+		// PoolID is the invalid pool id.
+		PoolID PoolID
+		// Err is cause of the error.
+		Err error
+	}
+
 	// PolicyID type def.
 	PolicyID string
 
@@ -182,17 +224,18 @@ type (
 //
 // ).
 func New(opts ...Option) (*Client, error) {
-	c := &Client{
+	cfg := &config{
 		commonHeaders: make(http.Header),
 		auth:          &AuthInfo{},
+		tip:           &lastTip{},
 	}
 	// set default base url
-	_ = c.setBaseURL(DefaultScheme, MainnetHost, DefaultAPIVersion, DefaultPort)
+	_ = cfg.setBaseURL(DefaultScheme, MainnetHost, DefaultAPIVersion, DefaultPort)
 
 	// set default common headers
-	c.commonHeaders.Set("Accept", "application/json")
-	c.commonHeaders.Set("Accept-Encoding", "gzip, deflate")
-	c.commonHeaders.Set(
+	cfg.commonHeaders.Set("Accept", "application/json")
+	cfg.commonHeaders.Set("Accept-Encoding", "gzip, deflate")
+	cfg.commonHeaders.Set(
 		"User-Agent",
 		fmt.Sprintf(
 			"go-koios/%s (%s %s) %s/%s https://github.com/cardano-community/go-koios",
@@ -206,28 +249,34 @@ func New(opts ...Option) (*Client, error) {
 
 	// If HttpClient option was not provided
 	// use default http.Client
-	if c.client == nil {
+	if cfg.client == nil {
 		// there is really no point to check that error
-		_ = HTTPClient(nil).apply(c)
+		_ = HTTPClient(nil).apply(cfg)
 	}
 
 	// Apply provided options
 	for _, opt := range opts {
-		if err := opt.apply(c); err != nil {
+		if err := opt.apply(cfg); err != nil {
 			return nil, err
 		}
 	}
 
-	if c.r == nil {
+	if cfg.r == nil {
 		// set default rate limit for outgoing requests if not configured.
-		_ = RateLimit(DefaultRateLimit).apply(c)
+		_ = RateLimit(DefaultRateLimit).apply(cfg)
 	}
 
-	if c.commonHeaders.Get("Origin") == "" {
+	if cfg.commonHeaders.Get("Origin") == "" {
 		// Sets default origin if option was not provided.
-		_ = Origin(DefaultOrigin).apply(c)
+		_ = Origin(DefaultOrigin).apply(cfg)
 	}
-	c.locked = true
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	cfg.locked = true
+
+	c := &Client{}
+	c.cfg.Store(cfg)
 	return c, nil
 }
 
@@ -248,6 +297,11 @@ func (v PaymentCredential) String() string {
 	return string(v)
 }
 
+// String returns StakeCredential as string.
+func (v StakeCredential) String() string {
+	return string(v)
+}
+
 // String returns BlockHash as string.
 func (v BlockHash) String() string {
 	return string(v)
@@ -268,8 +322,86 @@ func (v PoolID) String() string {
 	return string(v)
 }
 
+// Valid reports whether pool id is valid bech32 (pool1...)
+// or hex encoded pool id.
 func (v PoolID) Valid() bool {
-	return len(v) == 56
+	_, err := v.decode()
+	return err == nil
+}
+
+// Bech32 returns bech32 encoded (pool1...) form of the pool id.
+func (v PoolID) Bech32() (PoolID, error) {
+	b, err := v.decode()
+	if err != nil {
+		return "", err
+	}
+	s, err := bech32Encode(hrpPool, b)
+	if err != nil {
+		return "", &PoolIDError{PoolID: v, Err: err}
+	}
+	return PoolID(s), nil
+}
+
+// Hex returns hex encoded form of the pool id.
+func (v PoolID) Hex() (PoolID, error) {
+	b, err := v.decode()
+	if err != nil {
+		return "", err
+	}
+	return PoolID(hex.EncodeToString(b)), nil
+}
+
+// decode returns pool key hash of bech32 or hex encoded pool id.
+func (v PoolID) decode() ([]byte, error) {
+	if len(v) == 0 {
+		return nil, ErrNoPoolID
+	}
+	var (
+		b   []byte
+		err error
+	)
+	if strings.HasPrefix(strings.ToLower(v.String()), hrpPool+"1") {
+		var hrp string
+		hrp, b, err = bech32Decode(v.String())
+		if err == nil && hrp != hrpPool {
+			err = fmt.Errorf("unexpected prefix %s", hrp)
+		}
+	} else {
+		b, err = hex.DecodeString(v.String())
+	}
+	if err != nil {
+		return nil, &PoolIDError{PoolID: v, Err: err}
+	}
+	if len(b) != credentialLen {
+		return nil, &PoolIDError{
+			PoolID: v,
+			Err:    fmt.Errorf("pool key hash must be %d bytes got %d", credentialLen, len(b)),
+		}
+	}
+	return b, nil
+}
+
+// Error returns description of invalid pool id.
+func (e *PoolIDError) Error() string {
+	return fmt.Sprintf("%s %q: %s", ErrInvalidPoolID, e.PoolID, e.Err)
+}
+
+// Unwrap makes PoolIDError match ErrInvalidPoolID and its cause.
+func (e *PoolIDError) Unwrap() []error {
+	return []error{ErrInvalidPoolID, e.Err}
+}
+
+// bech32PoolIDs returns bech32 form of given pool ids.
+func bech32PoolIDs(pids []PoolID) ([]PoolID, error) {
+	res := make([]PoolID, len(pids))
+	for i, pid := range pids {
+		b, err := pid.Bech32()
+		if err != nil {
+			return nil, err
+		}
+		res[i] = b
+	}
+	return res, nil
 }
 
 // String returns PolicyID as string.
@@ -0,0 +1,1033 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+)
+
+// Transaction metadata codec converting between Go values,
+// cardano-cli detailed schema JSON and CBOR.
+//
+// Go values are mapped as follows:
+//   - integers, *big.Int and integral decimal.Decimal to int
+//   - string to text and []byte to bytes
+//   - slices and arrays to list
+//   - maps and structs to map, struct fields use `metadata:"key,omitempty"` tag
+//
+// Top level structs passed to NewMetadata and Metadata.Decode
+// map fields to metadata labels e.g. `metadata:"674"`.
+
+// MetadataLabelMessage is transaction metadata label of CIP-20 messages.
+const MetadataLabelMessage = "674"
+
+// MetadataMaxChunkLen is max length in bytes of metadata text and bytes values.
+const MetadataMaxChunkLen = 64
+
+type (
+	// MetadatumKind is kind of Metadatum value.
+	MetadatumKind int
+
+	// Metadatum is single transaction metadata value.
+	Metadatum struct {
+		Kind MetadatumKind
+		// Int value, set when Kind is MetadatumInt.
+		Int *big.Int
+		// Bytes value, set when Kind is MetadatumBytes.
+		Bytes []byte
+		// Text value, set when Kind is MetadatumText.
+		Text string
+		// List items, set when Kind is MetadatumList.
+		List []Metadatum
+		// Map key value pairs, set when Kind is MetadatumMap.
+		Map []MetadatumPair
+	}
+
+	// MetadatumPair is key value pair of Metadatum map.
+	MetadatumPair struct {
+		Key   Metadatum `json:"k"`
+		Value Metadatum `json:"v"`
+	}
+
+	// Metadata is transaction metadata keyed by label.
+	Metadata map[uint64]Metadatum
+
+	// CIP20Message is CIP-20 transaction message (label 674).
+	// See https://cips.cardano.org/cip/CIP-0020
+	CIP20Message struct {
+		Msg []string `metadata:"msg" json:"msg"`
+	}
+)
+
+// Metadatum kinds.
+const (
+	MetadatumInvalid MetadatumKind = iota
+	MetadatumInt
+	MetadatumBytes
+	MetadatumText
+	MetadatumList
+	MetadatumMap
+)
+
+var (
+	// metadataIntMax is max absolute value of metadata int 2^64-1.
+	metadataIntMax = new(big.Int).SetUint64(^uint64(0)) //nolint: gochecknoglobals
+
+	metadatumType = reflect.TypeOf(Metadatum{})       //nolint: gochecknoglobals
+	bigIntType    = reflect.TypeOf(big.Int{})         //nolint: gochecknoglobals
+	decimalType   = reflect.TypeOf(decimal.Decimal{}) //nolint: gochecknoglobals
+)
+
+// NewCIP20Message returns CIP-20 message splitting lines
+// longer than 64 bytes into multiple lines.
+func NewCIP20Message(lines ...string) CIP20Message {
+	msg := CIP20Message{}
+	for _, line := range lines {
+		msg.Msg = append(msg.Msg, MetadataChunks(line)...)
+	}
+	return msg
+}
+
+// String returns message lines joined without separator,
+// since long lines are split into chunks.
+func (m CIP20Message) String() string {
+	return strings.Join(m.Msg, "")
+}
+
+// Message decodes CIP-20 message from transaction metadata.
+func (m TxMetadata) Message() (*CIP20Message, error) {
+	raw, ok := m[MetadataLabelMessage]
+	if !ok {
+		return nil, fmt.Errorf("%w: no metadata with label %s", ErrNoData, MetadataLabelMessage)
+	}
+	var v struct {
+		Message CIP20Message `metadata:"674"`
+	}
+	if err := (TxMetadata{MetadataLabelMessage: raw}).Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v.Message, nil
+}
+
+// MetadataChunks splits string into chunks of max 64 bytes
+// without splitting UTF-8 encoded characters.
+func MetadataChunks(s string) []string {
+	if len(s) <= MetadataMaxChunkLen {
+		return []string{s}
+	}
+	var chunks []string
+	for len(s) > MetadataMaxChunkLen {
+		n := MetadataMaxChunkLen
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		if n == 0 {
+			// no rune start in invalid UTF-8, cut at max length.
+			n = MetadataMaxChunkLen
+		}
+		chunks = append(chunks, s[:n])
+		s = s[n:]
+	}
+	if len(s) > 0 {
+		chunks = append(chunks, s)
+	}
+	return chunks
+}
+
+// Metadata converts metadata returned by the API (no schema JSON)
+// to Metadata. Strings prefixed with 0x are decoded as bytes.
+func (m TxMetadata) Metadata() (Metadata, error) {
+	md := make(Metadata, len(m))
+	for k, raw := range m {
+		label, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid label %q", ErrMetadata, k)
+		}
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		var v any
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("%w: label %s: %s", ErrMetadata, k, err.Error())
+		}
+		if md[label], err = metadatumFromJSON(v); err != nil {
+			return nil, fmt.Errorf("%w: label %s", err, k)
+		}
+	}
+	return md, nil
+}
+
+// Decode decodes metadata returned by the API into v.
+// See Metadata.Decode.
+func (m TxMetadata) Decode(v any) error {
+	md, err := m.Metadata()
+	if err != nil {
+		return err
+	}
+	return md.Decode(v)
+}
+
+func metadatumFromJSON(v any) (Metadatum, error) {
+	switch v := v.(type) {
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return Metadatum{}, fmt.Errorf("%w: invalid int %s", ErrMetadata, v)
+		}
+		return Metadatum{Kind: MetadatumInt, Int: n}, nil
+	case string:
+		if strings.HasPrefix(v, "0x") {
+			if b, err := hex.DecodeString(v[2:]); err == nil {
+				return Metadatum{Kind: MetadatumBytes, Bytes: b}, nil
+			}
+		}
+		return Metadatum{Kind: MetadatumText, Text: v}, nil
+	case []any:
+		res := Metadatum{Kind: MetadatumList, List: make([]Metadatum, 0, len(v))}
+		for _, item := range v {
+			m, err := metadatumFromJSON(item)
+			if err != nil {
+				return Metadatum{}, err
+			}
+			res.List = append(res.List, m)
+		}
+		return res, nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		res := Metadatum{Kind: MetadatumMap, Map: make([]MetadatumPair, 0, len(v))}
+		for _, k := range keys {
+			key, _ := metadatumFromJSON(k)
+			val, err := metadatumFromJSON(v[k])
+			if err != nil {
+				return Metadatum{}, err
+			}
+			res.Map = append(res.Map, MetadatumPair{Key: key, Value: val})
+		}
+		return res, nil
+	}
+	return Metadatum{}, fmt.Errorf("%w: unsupported json value %v", ErrMetadata, v)
+}
+
+// NewMetadata returns Metadata of v which must be struct with fields
+// tagged with metadata label e.g. `metadata:"674"` or map with integer keys.
+func NewMetadata(v any) (Metadata, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	md := make(Metadata)
+	switch rv.Kind() {
+	case reflect.Struct:
+		for _, f := range metadataFields(rv.Type()) {
+			fv := rv.FieldByIndex(f.index)
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			label, err := strconv.ParseUint(f.key, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid label %q of field %s", ErrMetadata, f.key, f.name)
+			}
+			if md[label], err = newMetadatum(fv); err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			label, err := metadataLabel(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			if md[label], err = newMetadatum(iter.Value()); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported metadata type %T", ErrMetadata, v)
+	}
+	if err := md.Validate(); err != nil {
+		return nil, err
+	}
+	return md, nil
+}
+
+func metadataLabel(v reflect.Value) (uint64, error) {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() >= 0 {
+			return uint64(v.Int()), nil
+		}
+	case reflect.String:
+		if label, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return label, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: invalid label %v", ErrMetadata, v)
+}
+
+// NewMetadatum returns Metadatum of Go value v.
+func NewMetadatum(v any) (Metadatum, error) {
+	m, err := newMetadatum(reflect.ValueOf(v))
+	if err != nil {
+		return Metadatum{}, err
+	}
+	return m, m.Validate()
+}
+
+func newMetadatum(v reflect.Value) (Metadatum, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return Metadatum{}, fmt.Errorf("%w: nil value", ErrMetadata)
+		}
+		if v.Kind() == reflect.Pointer && v.Type().Elem() == bigIntType {
+			return Metadatum{Kind: MetadatumInt, Int: new(big.Int).Set(v.Interface().(*big.Int))}, nil
+		}
+		v = v.Elem()
+	}
+	switch v.Type() {
+	case metadatumType:
+		return v.Interface().(Metadatum), nil
+	case bigIntType:
+		n := v.Interface().(big.Int)
+		return Metadatum{Kind: MetadatumInt, Int: new(big.Int).Set(&n)}, nil
+	case decimalType:
+		d := v.Interface().(decimal.Decimal)
+		if !d.IsInteger() {
+			return Metadatum{}, fmt.Errorf("%w: decimal %s is not integer", ErrMetadata, d)
+		}
+		return Metadatum{Kind: MetadatumInt, Int: d.BigInt()}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Metadatum{Kind: MetadatumInt, Int: big.NewInt(v.Int())}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Metadatum{Kind: MetadatumInt, Int: new(big.Int).SetUint64(v.Uint())}, nil
+	case reflect.String:
+		return Metadatum{Kind: MetadatumText, Text: v.String()}, nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return Metadatum{Kind: MetadatumBytes, Bytes: b}, nil
+		}
+		res := Metadatum{Kind: MetadatumList, List: make([]Metadatum, 0, v.Len())}
+		for i := 0; i < v.Len(); i++ {
+			item, err := newMetadatum(v.Index(i))
+			if err != nil {
+				return Metadatum{}, err
+			}
+			res.List = append(res.List, item)
+		}
+		return res, nil
+	case reflect.Map:
+		res := Metadatum{Kind: MetadatumMap, Map: make([]MetadatumPair, 0, v.Len())}
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := newMetadatum(iter.Key())
+			if err != nil {
+				return Metadatum{}, err
+			}
+			val, err := newMetadatum(iter.Value())
+			if err != nil {
+				return Metadatum{}, err
+			}
+			res.Map = append(res.Map, MetadatumPair{Key: key, Value: val})
+		}
+		// canonical CBOR key order for deterministic encoding.
+		pairs := canonicalPairs{pairs: res.Map, keys: make([][]byte, len(res.Map))}
+		for i, kv := range res.Map {
+			key, err := kv.Key.cbor()
+			if err != nil {
+				return Metadatum{}, err
+			}
+			pairs.keys[i] = key
+		}
+		sort.Sort(pairs)
+		return res, nil
+	case reflect.Struct:
+		res := Metadatum{Kind: MetadatumMap}
+		for _, f := range metadataFields(v.Type()) {
+			fv := v.FieldByIndex(f.index)
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			val, err := newMetadatum(fv)
+			if err != nil {
+				return Metadatum{}, fmt.Errorf("%w: field %s", err, f.name)
+			}
+			res.Map = append(res.Map, MetadatumPair{
+				Key:   Metadatum{Kind: MetadatumText, Text: f.key},
+				Value: val,
+			})
+		}
+		return res, nil
+	}
+	return Metadatum{}, fmt.Errorf("%w: unsupported type %s", ErrMetadata, v.Type())
+}
+
+type metadataField struct {
+	name      string
+	key       string
+	index     []int
+	omitempty bool
+}
+
+// metadataFields returns exported fields of struct type t
+// with keys from metadata tag or field name.
+func metadataFields(t reflect.Type) []metadataField {
+	var fields []metadataField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("metadata")
+		if tag == "-" {
+			continue
+		}
+		key, opts, _ := strings.Cut(tag, ",")
+		if len(key) == 0 {
+			key = sf.Name
+		}
+		fields = append(fields, metadataField{
+			name:      sf.Name,
+			key:       key,
+			index:     sf.Index,
+			omitempty: opts == "omitempty",
+		})
+	}
+	return fields
+}
+
+// canonicalPairs sorts map pairs by CBOR encoding of their keys.
+type canonicalPairs struct {
+	pairs []MetadatumPair
+	keys  [][]byte
+}
+
+func (p canonicalPairs) Len() int { return len(p.pairs) }
+
+func (p canonicalPairs) Less(i, j int) bool {
+	if len(p.keys[i]) != len(p.keys[j]) {
+		return len(p.keys[i]) < len(p.keys[j])
+	}
+	return bytes.Compare(p.keys[i], p.keys[j]) < 0
+}
+
+func (p canonicalPairs) Swap(i, j int) {
+	p.pairs[i], p.pairs[j] = p.pairs[j], p.pairs[i]
+	p.keys[i], p.keys[j] = p.keys[j], p.keys[i]
+}
+
+// Validate checks that metadata satisfies ledger rules.
+func (md Metadata) Validate() error {
+	for label, m := range md {
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("%w: label %d", err, label)
+		}
+	}
+	return nil
+}
+
+// Validate checks that metadatum satisfies ledger rules:
+// text and bytes are max 64 bytes and int is in range ±(2^64-1).
+func (m Metadatum) Validate() error {
+	switch m.Kind {
+	case MetadatumInt:
+		if m.Int == nil || new(big.Int).Abs(m.Int).Cmp(metadataIntMax) > 0 {
+			return fmt.Errorf("%w: int %s out of range", ErrMetadata, m.Int)
+		}
+	case MetadatumBytes:
+		if len(m.Bytes) > MetadataMaxChunkLen {
+			return fmt.Errorf("%w: bytes longer than %d bytes", ErrMetadata, MetadataMaxChunkLen)
+		}
+	case MetadatumText:
+		if len(m.Text) > MetadataMaxChunkLen {
+			return fmt.Errorf("%w: text %q longer than %d bytes", ErrMetadata, m.Text, MetadataMaxChunkLen)
+		}
+		if !utf8.ValidString(m.Text) {
+			return fmt.Errorf("%w: text is not valid utf-8", ErrMetadata)
+		}
+	case MetadatumList:
+		for _, item := range m.List {
+			if err := item.Validate(); err != nil {
+				return err
+			}
+		}
+	case MetadatumMap:
+		for _, kv := range m.Map {
+			if err := kv.Key.Validate(); err != nil {
+				return err
+			}
+			if err := kv.Value.Validate(); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%w: invalid metadatum kind %d", ErrMetadata, m.Kind)
+	}
+	return nil
+}
+
+// Decode decodes metadata into v which must be pointer to struct with
+// fields tagged with metadata label e.g. `metadata:"674"` or pointer to
+// map with integer keys. Missing labels leave struct fields untouched.
+func (md Metadata) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("%w: decode requires non nil pointer", ErrMetadata)
+	}
+	rv = rv.Elem()
+	switch rv.Kind() {
+	case reflect.Struct:
+		for _, f := range metadataFields(rv.Type()) {
+			label, err := strconv.ParseUint(f.key, 10, 64)
+			if err != nil {
+				return fmt.Errorf("%w: invalid label %q of field %s", ErrMetadata, f.key, f.name)
+			}
+			m, ok := md[label]
+			if !ok {
+				continue
+			}
+			if err := m.decode(rv.FieldByIndex(f.index)); err != nil {
+				return fmt.Errorf("%w: label %d", err, label)
+			}
+		}
+		return nil
+	case reflect.Map:
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+		for label, m := range md {
+			key := reflect.New(rv.Type().Key()).Elem()
+			if err := (Metadatum{Kind: MetadatumInt, Int: new(big.Int).SetUint64(label)}).decode(key); err != nil {
+				return err
+			}
+			val := reflect.New(rv.Type().Elem()).Elem()
+			if err := m.decode(val); err != nil {
+				return fmt.Errorf("%w: label %d", err, label)
+			}
+			rv.SetMapIndex(key, val)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: unsupported metadata type %T", ErrMetadata, v)
+}
+
+// Decode decodes metadatum into v which must be non nil pointer.
+func (m Metadatum) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("%w: decode requires non nil pointer", ErrMetadata)
+	}
+	return m.decode(rv.Elem())
+}
+
+// nolint: gocyclo, cyclop
+func (m Metadatum) decode(v reflect.Value) error {
+	if err := m.check(); err != nil {
+		return err
+	}
+	mismatch := func() error {
+		return fmt.Errorf("%w: can not decode %s into %s", ErrMetadata, m.kindName(), v.Type())
+	}
+	switch v.Type() {
+	case metadatumType:
+		v.Set(reflect.ValueOf(m))
+		return nil
+	case bigIntType:
+		if m.Kind != MetadatumInt {
+			return mismatch()
+		}
+		if !v.CanAddr() {
+			return mismatch()
+		}
+		v.Addr().Interface().(*big.Int).Set(m.Int)
+		return nil
+	case decimalType:
+		if m.Kind != MetadatumInt {
+			return mismatch()
+		}
+		v.Set(reflect.ValueOf(decimal.NewFromBigInt(m.Int, 0)))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return m.decode(v.Elem())
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			return mismatch()
+		}
+		val, err := m.Value()
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(val))
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if m.Kind != MetadatumInt || !m.Int.IsInt64() || v.OverflowInt(m.Int.Int64()) {
+			return mismatch()
+		}
+		v.SetInt(m.Int.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if m.Kind != MetadatumInt || !m.Int.IsUint64() || v.OverflowUint(m.Int.Uint64()) {
+			return mismatch()
+		}
+		v.SetUint(m.Int.Uint64())
+		return nil
+	case reflect.String:
+		switch m.Kind {
+		case MetadatumText:
+			v.SetString(m.Text)
+		case MetadatumList:
+			// strings longer than 64 bytes are commonly split into list of chunks.
+			var sb strings.Builder
+			for _, item := range m.List {
+				if item.Kind != MetadatumText {
+					return mismatch()
+				}
+				sb.WriteString(item.Text)
+			}
+			v.SetString(sb.String())
+		default:
+			return mismatch()
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 && m.Kind == MetadatumBytes {
+			v.SetBytes(append([]byte(nil), m.Bytes...))
+			return nil
+		}
+		items := m.List
+		if m.Kind != MetadatumList {
+			// single value where list is expected e.g. CIP-20 msg as string.
+			items = []Metadatum{m}
+		}
+		s := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := item.decode(s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	case reflect.Map:
+		if m.Kind != MetadatumMap {
+			return mismatch()
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMapWithSize(v.Type(), len(m.Map)))
+		}
+		for _, kv := range m.Map {
+			key := reflect.New(v.Type().Key()).Elem()
+			if err := kv.Key.decode(key); err != nil {
+				return err
+			}
+			val := reflect.New(v.Type().Elem()).Elem()
+			if err := kv.Value.decode(val); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, val)
+		}
+		return nil
+	case reflect.Struct:
+		if m.Kind != MetadatumMap {
+			return mismatch()
+		}
+		fields := metadataFields(v.Type())
+		for _, kv := range m.Map {
+			if kv.Key.Kind != MetadatumText {
+				continue
+			}
+			for _, f := range fields {
+				if f.key != kv.Key.Text {
+					continue
+				}
+				if err := kv.Value.decode(v.FieldByIndex(f.index)); err != nil {
+					return fmt.Errorf("%w: field %s", err, f.name)
+				}
+				break
+			}
+		}
+		return nil
+	}
+	return mismatch()
+}
+
+// Value returns metadatum as Go value: int64 or *big.Int, []byte,
+// string, []any and map[string]any when all map keys are text
+// otherwise map is returned as Metadatum.
+// It returns error when metadatum or any of its items is invalid.
+func (m Metadatum) Value() (any, error) {
+	if err := m.check(); err != nil {
+		return nil, err
+	}
+	switch m.Kind {
+	case MetadatumInt:
+		if m.Int.IsInt64() {
+			return m.Int.Int64(), nil
+		}
+		return new(big.Int).Set(m.Int), nil
+	case MetadatumBytes:
+		return m.Bytes, nil
+	case MetadatumText:
+		return m.Text, nil
+	case MetadatumList:
+		res := make([]any, 0, len(m.List))
+		for _, item := range m.List {
+			val, err := item.Value()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, val)
+		}
+		return res, nil
+	}
+	res := make(map[string]any, len(m.Map))
+	for _, kv := range m.Map {
+		val, err := kv.Value.Value()
+		if err != nil {
+			return nil, err
+		}
+		if kv.Key.Kind != MetadatumText {
+			return m, nil
+		}
+		res[kv.Key.Text] = val
+	}
+	return res, nil
+}
+
+// check reports invalid kind or missing int value of metadatum.
+// Nested items are checked when they are used.
+func (m Metadatum) check() error {
+	switch {
+	case m.Kind < MetadatumInt || m.Kind > MetadatumMap:
+		return fmt.Errorf("%w: invalid metadatum kind %d", ErrMetadata, m.Kind)
+	case m.Kind == MetadatumInt && m.Int == nil:
+		return fmt.Errorf("%w: nil int", ErrMetadata)
+	}
+	return nil
+}
+
+func (m Metadatum) kindName() string {
+	switch m.Kind {
+	case MetadatumInt:
+		return "int"
+	case MetadatumBytes:
+		return "bytes"
+	case MetadatumText:
+		return "string"
+	case MetadatumList:
+		return "list"
+	case MetadatumMap:
+		return "map"
+	}
+	return "invalid"
+}
+
+// MarshalJSON encodes metadatum in cardano-cli detailed schema.
+func (m Metadatum) MarshalJSON() ([]byte, error) {
+	switch m.Kind {
+	case MetadatumInt:
+		if m.Int == nil {
+			return nil, fmt.Errorf("%w: nil int", ErrMetadata)
+		}
+		return []byte(`{"int":` + m.Int.String() + `}`), nil
+	case MetadatumBytes:
+		return json.Marshal(map[string]string{"bytes": hex.EncodeToString(m.Bytes)})
+	case MetadatumText:
+		return json.Marshal(map[string]string{"string": m.Text})
+	case MetadatumList:
+		list := m.List
+		if list == nil {
+			list = []Metadatum{}
+		}
+		return json.Marshal(map[string][]Metadatum{"list": list})
+	case MetadatumMap:
+		pairs := m.Map
+		if pairs == nil {
+			pairs = []MetadatumPair{}
+		}
+		return json.Marshal(map[string][]MetadatumPair{"map": pairs})
+	}
+	return nil, fmt.Errorf("%w: invalid metadatum kind %d", ErrMetadata, m.Kind)
+}
+
+// UnmarshalJSON decodes metadatum from cardano-cli detailed schema.
+func (m *Metadatum) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Int    *json.Number     `json:"int"`
+		Bytes  *string          `json:"bytes"`
+		String *string          `json:"string"`
+		List   *[]Metadatum     `json:"list"`
+		Map    *[]MetadatumPair `json:"map"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*m = Metadatum{}
+	switch {
+	case raw.Int != nil:
+		n, ok := new(big.Int).SetString(raw.Int.String(), 10)
+		if !ok {
+			return fmt.Errorf("%w: invalid int %s", ErrMetadata, *raw.Int)
+		}
+		m.Kind, m.Int = MetadatumInt, n
+	case raw.Bytes != nil:
+		v, err := hex.DecodeString(*raw.Bytes)
+		if err != nil {
+			return fmt.Errorf("%w: invalid bytes: %s", ErrMetadata, err.Error())
+		}
+		m.Kind, m.Bytes = MetadatumBytes, v
+	case raw.String != nil:
+		m.Kind, m.Text = MetadatumText, *raw.String
+	case raw.List != nil:
+		m.Kind, m.List = MetadatumList, *raw.List
+	case raw.Map != nil:
+		m.Kind, m.Map = MetadatumMap, *raw.Map
+	default:
+		return fmt.Errorf("%w: unknown metadatum %s", ErrMetadata, string(b))
+	}
+	return nil
+}
+
+// MarshalJSON encodes metadata in cardano-cli detailed schema.
+func (md Metadata) MarshalJSON() ([]byte, error) {
+	out := make(map[string]Metadatum, len(md))
+	for label, m := range md {
+		out[strconv.FormatUint(label, 10)] = m
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes metadata from cardano-cli detailed schema.
+func (md *Metadata) UnmarshalJSON(b []byte) error {
+	var in map[string]Metadatum
+	if err := json.Unmarshal(b, &in); err != nil {
+		return err
+	}
+	*md = make(Metadata, len(in))
+	for k, m := range in {
+		label, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid label %q", ErrMetadata, k)
+		}
+		(*md)[label] = m
+	}
+	return nil
+}
+
+// MarshalCBOR encodes metadata as CBOR map of labels to metadatum
+// after validating it against ledger rules.
+func (md Metadata) MarshalCBOR() ([]byte, error) {
+	if err := md.Validate(); err != nil {
+		return nil, err
+	}
+	labels := make([]uint64, 0, len(md))
+	for label := range md {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i] < labels[j] })
+	w := &cborWriter{}
+	w.head(cborMap, uint64(len(md)))
+	for _, label := range labels {
+		w.head(cborUint, label)
+		if err := md[label].encode(w); err != nil {
+			return nil, err
+		}
+	}
+	return w.b, nil
+}
+
+// MarshalCBOR encodes metadatum as CBOR after validating it against ledger rules.
+func (m Metadatum) MarshalCBOR() ([]byte, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m.cbor()
+}
+
+func (m Metadatum) cbor() ([]byte, error) {
+	w := &cborWriter{}
+	if err := m.encode(w); err != nil {
+		return nil, err
+	}
+	return w.b, nil
+}
+
+func (m Metadatum) encode(w *cborWriter) error {
+	if err := m.check(); err != nil {
+		return err
+	}
+	switch m.Kind {
+	case MetadatumInt:
+		if m.Int.Sign() >= 0 {
+			w.head(cborUint, m.Int.Uint64())
+		} else {
+			// -1 - n
+			w.head(cborNegInt, new(big.Int).Not(m.Int).Uint64())
+		}
+	case MetadatumBytes:
+		w.bytes(m.Bytes)
+	case MetadatumText:
+		w.text(m.Text)
+	case MetadatumList:
+		w.head(cborArray, uint64(len(m.List)))
+		for _, item := range m.List {
+			if err := item.encode(w); err != nil {
+				return err
+			}
+		}
+	case MetadatumMap:
+		w.head(cborMap, uint64(len(m.Map)))
+		for _, kv := range m.Map {
+			if err := kv.Key.encode(w); err != nil {
+				return err
+			}
+			if err := kv.Value.encode(w); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeMetadataCBOR decodes CBOR encoded transaction metadata.
+func DecodeMetadataCBOR(b []byte) (Metadata, error) {
+	r := newCBORReader(b)
+	n, err := r.mapLen()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMetadata, err.Error())
+	}
+	// length is not trusted for preallocation, since it is read from input.
+	md := make(Metadata)
+	for i := 0; i < n; i++ {
+		label, err := r.uint()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMetadata, err.Error())
+		}
+		if md[label], err = decodeMetadatum(r); err != nil {
+			return nil, fmt.Errorf("%w: label %d: %s", ErrMetadata, label, err.Error())
+		}
+	}
+	if !r.done() {
+		return nil, fmt.Errorf("%w: trailing bytes", ErrMetadata)
+	}
+	return md, nil
+}
+
+// DecodeMetadatumCBOR decodes CBOR encoded metadatum.
+func DecodeMetadatumCBOR(b []byte) (Metadatum, error) {
+	r := newCBORReader(b)
+	m, err := decodeMetadatum(r)
+	if err != nil {
+		return Metadatum{}, fmt.Errorf("%w: %s", ErrMetadata, err.Error())
+	}
+	if !r.done() {
+		return Metadatum{}, fmt.Errorf("%w: trailing bytes", ErrMetadata)
+	}
+	return m, nil
+}
+
+func decodeMetadatum(r *cborReader) (Metadatum, error) {
+	major, err := r.peek()
+	if err != nil {
+		return Metadatum{}, err
+	}
+	switch major {
+	case cborUint:
+		n, err := r.uint()
+		return Metadatum{Kind: MetadatumInt, Int: new(big.Int).SetUint64(n)}, err
+	case cborNegInt:
+		n, err := r.expect(cborNegInt)
+		return Metadatum{Kind: MetadatumInt, Int: new(big.Int).Not(new(big.Int).SetUint64(n))}, err
+	case cborBytes, cborText:
+		var b []byte
+		if r.indefinite(major) {
+			for !r.breakCode() {
+				chunk, err := r.bytesOf(major)
+				if err != nil {
+					return Metadatum{}, err
+				}
+				b = append(b, chunk...)
+			}
+		} else if b, err = r.bytesOf(major); err != nil {
+			return Metadatum{}, err
+		}
+		if major == cborText {
+			return Metadatum{Kind: MetadatumText, Text: string(b)}, nil
+		}
+		return Metadatum{Kind: MetadatumBytes, Bytes: append([]byte(nil), b...)}, nil
+	case cborArray:
+		m := Metadatum{Kind: MetadatumList, List: []Metadatum{}}
+		err := decodeCBORItems(r, cborArray, func() error {
+			item, err := decodeMetadatum(r)
+			m.List = append(m.List, item)
+			return err
+		})
+		return m, err
+	case cborMap:
+		m := Metadatum{Kind: MetadatumMap, Map: []MetadatumPair{}}
+		err := decodeCBORItems(r, cborMap, func() error {
+			key, err := decodeMetadatum(r)
+			if err != nil {
+				return err
+			}
+			val, err := decodeMetadatum(r)
+			m.Map = append(m.Map, MetadatumPair{Key: key, Value: val})
+			return err
+		})
+		return m, err
+	}
+	return Metadatum{}, fmt.Errorf("%w: unsupported major type %d in metadata", errCBOR, major)
+}
+
+// decodeCBORItems calls item for each item of definite
+// or indefinite length array or map.
+func decodeCBORItems(r *cborReader, major byte, item func() error) error {
+	if r.indefinite(major) {
+		for !r.breakCode() {
+			if r.done() {
+				return fmt.Errorf("%w: unexpected end of input", errCBOR)
+			}
+			if err := item(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	n, err := r.expect(major)
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < n; i++ {
+		if err := item(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMetadataCBORRoundTrip(t *testing.T) {
+	maxInt := new(big.Int).SetUint64(^uint64(0))
+	tests := []struct {
+		name string
+		md   any
+		cbor string
+	}{
+		{
+			"cip20 message",
+			map[uint64]any{674: CIP20Message{Msg: []string{"hello"}}},
+			"a11902a2a1636d7367816568656c6c6f",
+		},
+		{"int", map[uint64]any{1: 1}, "a10101"},
+		{"negative int", map[uint64]any{1: -1}, "a10120"},
+		{"max int", map[uint64]any{1: maxInt}, "a1011bffffffffffffffff"},
+		{"min int", map[uint64]any{1: new(big.Int).Neg(maxInt)}, "a1013bfffffffffffffffe"},
+		{"bytes", map[uint64]any{1: []byte{0xca, 0xfe}}, "a10142cafe"},
+		{"text", map[uint64]any{1: "a"}, "a1016161"},
+		{"list", map[uint64]any{1: []any{1, "a"}}, "a10182016161"},
+		{"canonical map keys", map[uint64]any{1: map[string]int{"bb": 2, "a": 1, "c": 3}}, "a101a361610161630362626202"},
+		{"labels sorted", map[uint64]any{2: 2, 1: 1}, "a201010202"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := NewMetadata(tt.md)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := md.MarshalCBOR()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(b); got != tt.cbor {
+				t.Errorf("encode: expected %s got %s", tt.cbor, got)
+			}
+			decoded, err := DecodeMetadataCBOR(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			again, err := decoded.MarshalCBOR()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(again) != tt.cbor {
+				t.Errorf("round trip: expected %s got %x", tt.cbor, again)
+			}
+
+			js, err := json.Marshal(md)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fromJSON Metadata
+			if err := json.Unmarshal(js, &fromJSON); err != nil {
+				t.Fatal(err)
+			}
+			if b, err = fromJSON.MarshalCBOR(); err != nil || hex.EncodeToString(b) != tt.cbor {
+				t.Errorf("json round trip: expected %s got %x (%v)", tt.cbor, b, err)
+			}
+		})
+	}
+}
+
+func TestMetadataCBORDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		cbor string
+		want any
+	}{
+		{"indefinite text", "a1017f61616162ff", "ab"},
+		{"indefinite bytes", "a1015f41014102ff", []byte{1, 2}},
+		{"indefinite list", "a1019f0102ff", []any{int64(1), int64(2)}},
+		{"indefinite map", "a101bf616101ff", map[string]any{"a": int64(1)}},
+		{"big negative", "a1013bffffffffffffffff", new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 64))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := DecodeMetadataCBOR(mustHex(t, tt.cbor))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := md[1].Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %#v got %#v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMetadataCBORDecodeMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		cbor string
+	}{
+		{"empty", ""},
+		{"not a map", "8101"},
+		{"huge map length", "ba7fffffff"},
+		{"huge list length", "a1019b7fffffffffffffff"},
+		{"huge text length", "a1017b7fffffffffffffff"},
+		{"truncated map", "a2010102"},
+		{"truncated value", "a101"},
+		{"text label", "a1616101"},
+		{"trailing bytes", "a1010100"},
+		{"unsupported simple value", "a101f6"},
+		{"unsupported tag", "a101c101"},
+		{"unterminated indefinite text", "a1017f6161"},
+		{"unterminated indefinite list", "a1019f01"},
+		{"indefinite text with bytes chunk", "a1017f4161ff"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeMetadataCBOR(mustHex(t, tt.cbor)); !errors.Is(err, ErrMetadata) {
+				t.Errorf("expected %v got %v", ErrMetadata, err)
+			}
+		})
+	}
+}
+
+func TestMetadatumInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Metadatum
+	}{
+		{"zero value", Metadatum{}},
+		{"unknown kind", Metadatum{Kind: MetadatumMap + 1}},
+		{"nil int", Metadatum{Kind: MetadatumInt}},
+		{"list with nil int", Metadatum{Kind: MetadatumList, List: []Metadatum{{Kind: MetadatumInt}}}},
+		{"map with invalid value", Metadatum{Kind: MetadatumMap, Map: []MetadatumPair{
+			{Key: Metadatum{Kind: MetadatumText, Text: "a"}},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.m.Value(); !errors.Is(err, ErrMetadata) {
+				t.Errorf("Value: expected %v got %v", ErrMetadata, err)
+			}
+			var (
+				anyVal any
+				bigVal big.Int
+				decVal decimal.Decimal
+				intVal int
+			)
+			for _, v := range []any{&anyVal, &bigVal, &decVal, &intVal} {
+				if err := tt.m.Decode(v); !errors.Is(err, ErrMetadata) {
+					t.Errorf("Decode(%T): expected %v got %v", v, ErrMetadata, err)
+				}
+			}
+			if _, err := tt.m.MarshalCBOR(); !errors.Is(err, ErrMetadata) {
+				t.Errorf("MarshalCBOR: expected %v got %v", ErrMetadata, err)
+			}
+			if _, err := json.Marshal(tt.m); !errors.Is(err, ErrMetadata) {
+				t.Errorf("MarshalJSON: expected %v got %v", ErrMetadata, err)
+			}
+			if _, err := (Metadata{1: tt.m}).MarshalCBOR(); !errors.Is(err, ErrMetadata) {
+				t.Errorf("Metadata.MarshalCBOR: expected %v got %v", ErrMetadata, err)
+			}
+		})
+	}
+}
+
+func TestMetadatumDecode(t *testing.T) {
+	md, err := DecodeMetadataCBOR(mustHex(t, "a11902a2a1636d7367816568656c6c6f"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg struct {
+		CIP20 CIP20Message `metadata:"674"`
+	}
+	if err := md.Decode(&msg); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(msg.CIP20.Msg, []string{"hello"}) {
+		t.Errorf("expected [hello] got %v", msg.CIP20.Msg)
+	}
+
+	var n uint8
+	if err := (Metadatum{Kind: MetadatumInt, Int: big.NewInt(256)}).Decode(&n); !errors.Is(err, ErrMetadata) {
+		t.Errorf("expected overflow error got %v", err)
+	}
+	if err := (Metadatum{Kind: MetadatumInt, Int: big.NewInt(1)}).Decode(nil); !errors.Is(err, ErrMetadata) {
+		t.Errorf("expected nil pointer error got %v", err)
+	}
+}
+
+func TestMetadataChunks(t *testing.T) {
+	// 63 ASCII bytes followed by 3 byte rune crossing byte 64.
+	crossing := strings.Repeat("a", 63) + "€" + "b"
+	tests := []struct {
+		name string
+		s    string
+		want []string
+	}{
+		{name: "empty", s: "", want: []string{""}},
+		{name: "64 bytes", s: strings.Repeat("a", 64), want: []string{strings.Repeat("a", 64)}},
+		{name: "65 bytes", s: strings.Repeat("a", 65), want: []string{strings.Repeat("a", 64), "a"}},
+		{name: "rune crossing 64", s: crossing, want: []string{strings.Repeat("a", 63), "€b"}},
+		{
+			name: "invalid utf-8",
+			s:    strings.Repeat("\x80", 100),
+			want: []string{strings.Repeat("\x80", 64), strings.Repeat("\x80", 36)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MetadataChunks(tt.s)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %q got %q", tt.want, got)
+			}
+			if strings.Join(got, "") != tt.s {
+				t.Errorf("chunks do not join to input")
+			}
+			for _, c := range got {
+				if len(c) > MetadataMaxChunkLen {
+					t.Errorf("chunk %q is longer than %d bytes", c, MetadataMaxChunkLen)
+				}
+			}
+		})
+	}
+
+	msg := NewCIP20Message(crossing, "short")
+	if len(msg.Msg) != 3 || msg.String() != crossing+"short" {
+		t.Errorf("unexpected message %q", msg.Msg)
+	}
+}
@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// MultiClient holds one Client per network and routes
+	// address and stake address based calls to the network
+	// of the addresses.
+	//
+	// Addresses are matched by bech32 prefix and network id, Byron addresses
+	// by protocol magic. Testnets share prefixes, so routing fails as ambiguous
+	// when more than one testnet client is set, use RouteIn to select
+	// the testnet explicitly. Pool ids are same on all networks,
+	// so pool calls require explicit network.
+	MultiClient struct {
+		mu       sync.RWMutex
+		networks map[string]Network
+		clients  map[string]*Client
+	}
+)
+
+// NewMultiClient returns MultiClient with client for each network
+// created with WithNetwork option and provided options.
+func NewMultiClient(networks []Network, opts ...Option) (*MultiClient, error) {
+	m := &MultiClient{
+		networks: make(map[string]Network),
+		clients:  make(map[string]*Client),
+	}
+	for _, n := range networks {
+		c, err := New(append([]Option{WithNetwork(n)}, opts...)...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", n.Name, err)
+		}
+		m.Set(n, c)
+	}
+	return m, nil
+}
+
+// Set sets client used for the network e.g. client of self-hosted instance.
+func (m *MultiClient) Set(n Network, c *Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.clients == nil {
+		m.networks = make(map[string]Network)
+		m.clients = make(map[string]*Client)
+	}
+	m.networks[n.Name] = n
+	m.clients[n.Name] = c
+}
+
+// Networks returns networks of the clients sorted by name.
+func (m *MultiClient) Networks() []Network {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := make([]Network, 0, len(m.networks))
+	for _, n := range m.networks {
+		list = append(list, n)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Client returns client of the network.
+func (m *MultiClient) Client(n Network) (*Client, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.clients[n.Name]
+	if !ok {
+		return nil, fmt.Errorf("%w: no client for network %s", ErrNetworkRouting, n.Name)
+	}
+	return c, nil
+}
+
+// AddressNetwork returns network of the address.
+func (m *MultiClient) AddressNetwork(addr Address) (Network, error) {
+	a, err := addr.Decode()
+	if err != nil {
+		return Network{}, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var matches []Network
+	for _, n := range m.networks {
+		if addressOnNetwork(a, n) {
+			matches = append(matches, n)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Network{}, fmt.Errorf("%w: no client for network of address %s", ErrNetworkRouting, addr)
+	case 1:
+		return matches[0], nil
+	}
+	names := make([]string, 0, len(matches))
+	for _, n := range matches {
+		names = append(names, n.Name)
+	}
+	sort.Strings(names)
+	return Network{}, fmt.Errorf("%w: address %s is ambiguous between networks %s",
+		ErrNetworkRouting, addr, strings.Join(names, ", "))
+}
+
+// Route returns client of network of all addresses. It fails when
+// addresses belong to different networks.
+func (m *MultiClient) Route(addrs ...Address) (*Client, error) {
+	if len(addrs) == 0 {
+		return nil, ErrNoAddressesProvided
+	}
+	var network Network
+	for i, addr := range addrs {
+		n, err := m.AddressNetwork(addr)
+		if err != nil {
+			return nil, fmt.Errorf("%w (index %d)", err, i)
+		}
+		if i == 0 {
+			network = n
+			continue
+		}
+		if n.Name != network.Name {
+			return nil, fmt.Errorf("%w: mixed networks in batch, address 0 is %s and address %d is %s",
+				ErrNetworkRouting, network.Name, i, n.Name)
+		}
+	}
+	return m.Client(network)
+}
+
+// RouteIn returns client of network n after checking that all addresses
+// may belong to it. It is used when network can not be determined from
+// the addresses e.g. for testnet addresses when several testnets are set.
+func (m *MultiClient) RouteIn(n Network, addrs ...Address) (*Client, error) {
+	for i, addr := range addrs {
+		a, err := addr.Decode()
+		if err != nil {
+			return nil, fmt.Errorf("%w (index %d)", err, i)
+		}
+		if !addressOnNetwork(a, n) {
+			return nil, fmt.Errorf("%w: address %d %s does not belong to network %s",
+				ErrNetworkRouting, i, addr, n.Name)
+		}
+	}
+	return m.Client(n)
+}
+
+// addressOnNetwork reports whether decoded address may belong to network n.
+func addressOnNetwork(a *DecodedAddress, n Network) bool {
+	if a.ProtocolMagic != nil {
+		return n.Magic == *a.ProtocolMagic
+	}
+	if n.NetworkID != a.NetworkID {
+		return false
+	}
+	return len(a.HRP) == 0 || a.HRP == n.AddressHRP || a.HRP == n.StakeHRP
+}
+
+// GetAddressInfo routes GetAddressInfo to network of the address.
+func (m *MultiClient) GetAddressInfo(
+	ctx context.Context,
+	addr Address,
+	opts *RequestOptions,
+) (*AddressInfoResponse, error) {
+	c, err := m.Route(addr)
+	if err != nil {
+		res := &AddressInfoResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAddressInfo(ctx, addr, opts)
+}
+
+// GetAddressesInfo routes GetAddressesInfo to network of the addresses.
+func (m *MultiClient) GetAddressesInfo(
+	ctx context.Context,
+	addrs []Address,
+	opts *RequestOptions,
+) (*AddressesInfoResponse, error) {
+	c, err := m.Route(addrs...)
+	if err != nil {
+		res := &AddressesInfoResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAddressesInfo(ctx, addrs, opts)
+}
+
+// GetAddressUTxOs routes GetAddressUTxOs to network of the addresses.
+func (m *MultiClient) GetAddressUTxOs(
+	ctx context.Context,
+	addrs []Address,
+	extended bool,
+	opts *RequestOptions,
+) (*UTxOsResponse, error) {
+	c, err := m.Route(addrs...)
+	if err != nil {
+		res := &UTxOsResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAddressUTxOs(ctx, addrs, extended, opts)
+}
+
+// GetAddressesAssets routes GetAddressesAssets to network of the addresses.
+func (m *MultiClient) GetAddressesAssets(
+	ctx context.Context,
+	addrs []Address,
+	opts *RequestOptions,
+) (*AddressesAssetsResponse, error) {
+	c, err := m.Route(addrs...)
+	if err != nil {
+		res := &AddressesAssetsResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAddressesAssets(ctx, addrs, opts)
+}
+
+// GetAccountInfo routes GetAccountInfo to network of the stake addresses.
+func (m *MultiClient) GetAccountInfo(
+	ctx context.Context,
+	accs []Address,
+	opts *RequestOptions,
+) (*AccountsInfoResponse, error) {
+	c, err := m.Route(accs...)
+	if err != nil {
+		res := &AccountsInfoResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAccountInfo(ctx, accs, opts)
+}
+
+// GetAccountAssets routes GetAccountAssets to network of the stake addresses.
+func (m *MultiClient) GetAccountAssets(
+	ctx context.Context,
+	accs []Address,
+	opts *RequestOptions,
+) (*AccountsAssetsResponse, error) {
+	c, err := m.Route(accs...)
+	if err != nil {
+		res := &AccountsAssetsResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAccountAssets(ctx, accs, opts)
+}
+
+// GetAccountUtxos routes GetAccountUtxos to network of the stake addresses.
+func (m *MultiClient) GetAccountUtxos(
+	ctx context.Context,
+	accs []Address,
+	extended bool,
+	opts *RequestOptions,
+) (*UTxOsResponse, error) {
+	c, err := m.Route(accs...)
+	if err != nil {
+		res := &UTxOsResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetAccountUtxos(ctx, accs, extended, opts)
+}
+
+// GetPoolInfos sends GetPoolInfos to client of given network,
+// since pool ids do not encode network.
+func (m *MultiClient) GetPoolInfos(
+	ctx context.Context,
+	n Network,
+	pids []PoolID,
+	opts *RequestOptions,
+) (*PoolInfosResponse, error) {
+	c, err := m.Client(n)
+	if err != nil {
+		res := &PoolInfosResponse{}
+		res.applyError(nil, err)
+		return res, err
+	}
+	return c.GetPoolInfos(ctx, pids, opts)
+}
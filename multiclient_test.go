@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMultiClientRoute(t *testing.T) {
+	const (
+		mainnetAddr Address = "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"
+		testnetAddr Address = "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"
+		preprodAddr Address = "FHnt4NL7yPXh7nSaGY1gHFPRYXL7oJnV4vyiif7gMV7zt4S1pmuLvDKjFrfX15D"
+	)
+	mc, err := NewMultiClient([]Network{Mainnet, PreProd, Preview})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientOf := func(n Network) *Client {
+		c, err := mc.Client(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		network *Network
+		addrs   []Address
+		want    *Client
+	}{
+		{"mainnet", nil, []Address{mainnetAddr}, clientOf(Mainnet)},
+		{"byron magic", nil, []Address{preprodAddr}, clientOf(PreProd)},
+		{"ambiguous testnet", nil, []Address{testnetAddr}, nil},
+		{"mixed networks", nil, []Address{mainnetAddr, preprodAddr}, nil},
+		{"no addresses", nil, nil, nil},
+		{"testnet in preview", &Preview, []Address{testnetAddr}, clientOf(Preview)},
+		{"testnet in preprod", &PreProd, []Address{testnetAddr, preprodAddr}, clientOf(PreProd)},
+		{"byron magic of other testnet", &Preview, []Address{preprodAddr}, nil},
+		{"mainnet address in testnet", &PreProd, []Address{testnetAddr, mainnetAddr}, nil},
+		{"network without client", &Guild, []Address{testnetAddr}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				c   *Client
+				err error
+			)
+			if tt.network != nil {
+				c, err = mc.RouteIn(*tt.network, tt.addrs...)
+			} else {
+				c, err = mc.Route(tt.addrs...)
+			}
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("expected error got client %p", c)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c != tt.want {
+				t.Error("routed to wrong client")
+			}
+		})
+	}
+
+	if _, err := mc.Route(testnetAddr); !errors.Is(err, ErrNetworkRouting) {
+		t.Errorf("expected %v got %v", ErrNetworkRouting, err)
+	}
+}
+
+func TestMultiClientRouteSingleTestnet(t *testing.T) {
+	const (
+		testnetAddr  Address = "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz"
+		testnetStake Address = "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn"
+		preprodAddr  Address = "FHnt4NL7yPXh7nSaGY1gHFPRYXL7oJnV4vyiif7gMV7zt4S1pmuLvDKjFrfX15D"
+	)
+	mc, err := NewMultiClient([]Network{Mainnet, Preview})
+	if err != nil {
+		t.Fatal(err)
+	}
+	preview, err := mc.Client(Preview)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// only testnet configured, testnet addresses are routed to it.
+	c, err := mc.Route(testnetAddr, testnetStake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != preview {
+		t.Error("expected testnet address to be routed to preview client")
+	}
+	// byron address carries magic of testnet which is not configured.
+	if _, err := mc.Route(preprodAddr); !errors.Is(err, ErrNetworkRouting) {
+		t.Errorf("expected %v got %v", ErrNetworkRouting, err)
+	}
+
+	// second testnet makes testnet addresses ambiguous.
+	preprod, err := New(WithNetwork(PreProd))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mc.Set(PreProd, preprod)
+	if _, err := mc.Route(testnetAddr); !errors.Is(err, ErrNetworkRouting) {
+		t.Errorf("expected %v got %v", ErrNetworkRouting, err)
+	}
+}
@@ -34,10 +34,7 @@ type (
 	}
 
 	// TipResponse response of /tip.
-	TipResponse struct {
-		Response
-		Data Tip `json:"data"`
-	}
+	TipResponse = Response[*Tip]
 )
 
 // GetTip returns the tip info about the latest block seen by chain.
@@ -46,15 +43,16 @@ func (c *Client) GetTip(
 	opts *RequestOptions,
 ) (res *TipResponse, err error) {
 	res = &TipResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/tip", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/tip", nil, opts)
 	if err != nil {
 		return res, err
 	}
 	tips := []Tip{}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &tips)
-	if len(tips) == 1 {
-		res.Data = tips[0]
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &tips); err != nil {
+		return res, err
 	}
+	res.Data, err = firstItem(tips, "tip")
+	c.config().tip.set(res.Data)
 	return res, err
 }
 
@@ -109,10 +107,7 @@ type (
 	}
 
 	// GenesisResponse response of /genesis.
-	GenesisResponse struct {
-		Response
-		Data Genesis `json:"data"`
-	}
+	GenesisResponse = Response[*Genesis]
 )
 
 // GetGenesis returns the Genesis parameters used to start specific era on chain.
@@ -121,15 +116,15 @@ func (c *Client) GetGenesis(
 	opts *RequestOptions,
 ) (*GenesisResponse, error) {
 	res := &GenesisResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/genesis", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/genesis", nil, opts)
 	if err != nil {
 		return res, err
 	}
 	genesisres := []Genesis{}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &genesisres)
-	if len(genesisres) == 1 {
-		res.Data = genesisres[0]
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &genesisres); err != nil {
+		return res, err
 	}
+	res.Data, err = firstItem(genesisres, "genesis")
 	return res, err
 }
 
@@ -159,10 +154,7 @@ type (
 	}
 
 	// TotalsResponse represents response from `/totals` endpoint.
-	TotalsResponse struct {
-		Response
-		Data []Totals `json:"data"`
-	}
+	TotalsResponse = ListResponse[Totals]
 )
 
 // GetTotals returns the circulating utxo, treasury, rewards, supply and
@@ -172,18 +164,26 @@ func (c *Client) GetTotals(
 	epoch *EpochNo,
 	opts *RequestOptions,
 ) (*TotalsResponse, error) {
-	if opts == nil {
-		opts = c.NewRequestOptions()
-	}
+	opts = c.requestOptions(opts)
 	if epoch != nil {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 	res := &TotalsResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/totals", nil, opts)
+
+	if err := c.validate(func(v *validator) {
+		if epoch != nil {
+			v.epoch("_epoch_no", *epoch)
+		}
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/totals", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 type (
@@ -198,10 +198,7 @@ type (
 	}
 
 	// ParamUpdatesResponse represents response from `/param_updates` endpoint.
-	ParamUpdatesResponse struct {
-		Response
-		Data []ParamUpdate `json:"data"`
-	}
+	ParamUpdatesResponse = ListResponse[ParamUpdate]
 )
 
 // GetParamUpdates returns the parameter updates for the network.
@@ -209,15 +206,13 @@ func (c *Client) GetParamUpdates(
 	ctx context.Context,
 	opts *RequestOptions,
 ) (*ParamUpdatesResponse, error) {
-	if opts == nil {
-		opts = c.NewRequestOptions()
-	}
+	opts = c.requestOptions(opts)
 	res := &ParamUpdatesResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/param_updates", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/param_updates", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 type (
@@ -233,26 +228,21 @@ type (
 	}
 
 	// ReserveWithdrawalsResponse represents response from `/reserve_withdrawals` endpoint.
-	ReserveWithdrawalsResponse struct {
-		Response
-		Data []ReserveWithdrawal `json:"data"`
-	}
+	ReserveWithdrawalsResponse = ListResponse[ReserveWithdrawal]
 )
 
 func (c *Client) GetReserveWithdrawals(
 	ctx context.Context,
 	opts *RequestOptions,
 ) (*ReserveWithdrawalsResponse, error) {
-	if opts == nil {
-		opts = c.NewRequestOptions()
-	}
+	opts = c.requestOptions(opts)
 
 	res := &ReserveWithdrawalsResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/reserve_withdrawals", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/reserve_withdrawals", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func (g *Genesis) AlonzoGenesisMap() (map[string]any, error) {
@@ -274,24 +264,19 @@ type (
 		Amount       decimal.Decimal `json:"amount"`
 		StakeAddress Address         `json:"stake_address"`
 	}
-	TreasuryWithdrawalsResponse struct {
-		Response
-		Data []TreasuryWithdrawal `json:"data"`
-	}
+	TreasuryWithdrawalsResponse = ListResponse[TreasuryWithdrawal]
 )
 
 func (c *Client) GetTreasuryWithdrawals(
 	ctx context.Context,
 	opts *RequestOptions,
 ) (*TreasuryWithdrawalsResponse, error) {
-	if opts == nil {
-		opts = c.NewRequestOptions()
-	}
+	opts = c.requestOptions(opts)
 
 	res := &TreasuryWithdrawalsResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/treasury_withdrawals", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/treasury_withdrawals", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
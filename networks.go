@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type (
+	// Network is profile of Cardano network.
+	Network struct {
+		// Name of the network e.g. mainnet.
+		Name string `json:"name"`
+		// Host of Koios instance serving the network.
+		Host string `json:"host"`
+		// Magic is network magic from genesis.
+		Magic uint32 `json:"magic"`
+		// NetworkID is network id used in Shelley addresses.
+		NetworkID NetworkID `json:"network_id"`
+		// AddressHRP and StakeHRP are bech32 prefixes of payment and stake addresses.
+		AddressHRP string `json:"address_hrp"`
+		StakeHRP   string `json:"stake_hrp"`
+		// SystemStart is time of the first slot, zero when not known.
+		SystemStart time.Time `json:"system_start"`
+		// ShelleyStartEpoch is first Shelley era epoch,
+		// zero when network started in Shelley era.
+		ShelleyStartEpoch EpochNo `json:"shelley_start_epoch"`
+	}
+
+	// networkCheck holds result of lazy network verification.
+	networkCheck struct {
+		mu   sync.Mutex
+		done bool
+		err  error
+	}
+)
+
+// Predefined network profiles.
+var (
+	Mainnet = Network{ //nolint: gochecknoglobals
+		Name:              "mainnet",
+		Host:              MainnetHost,
+		Magic:             764824073,
+		NetworkID:         NetworkIDMainnet,
+		AddressHRP:        hrpAddr,
+		StakeHRP:          hrpStake,
+		SystemStart:       time.Date(2017, 9, 23, 21, 44, 51, 0, time.UTC),
+		ShelleyStartEpoch: 208,
+	}
+	PreProd = Network{ //nolint: gochecknoglobals
+		Name:              "preprod",
+		Host:              PreProdHost,
+		Magic:             1,
+		NetworkID:         NetworkIDTestnet,
+		AddressHRP:        hrpAddrTest,
+		StakeHRP:          hrpStakeTest,
+		SystemStart:       time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		ShelleyStartEpoch: 4,
+	}
+	Preview = Network{ //nolint: gochecknoglobals
+		Name:        "preview",
+		Host:        PreviewHost,
+		Magic:       2,
+		NetworkID:   NetworkIDTestnet,
+		AddressHRP:  hrpAddrTest,
+		StakeHRP:    hrpStakeTest,
+		SystemStart: time.Date(2022, 10, 25, 0, 0, 0, 0, time.UTC),
+	}
+	Guild = Network{ //nolint: gochecknoglobals
+		Name:       "guild",
+		Host:       GuildHost,
+		Magic:      141,
+		NetworkID:  NetworkIDTestnet,
+		AddressHRP: hrpAddrTest,
+		StakeHRP:   hrpStakeTest,
+	}
+)
+
+// Networks returns predefined network profiles.
+func Networks() []Network {
+	return []Network{Mainnet, PreProd, Preview, Guild}
+}
+
+// NetworkByMagic returns predefined network profile with given magic.
+func NetworkByMagic(magic uint32) (Network, bool) {
+	for _, n := range Networks() {
+		if n.Magic == magic {
+			return n, true
+		}
+	}
+	return Network{}, false
+}
+
+// NetworkByName returns predefined network profile with given name.
+func NetworkByName(name string) (Network, bool) {
+	for _, n := range Networks() {
+		if n.Name == name {
+			return n, true
+		}
+	}
+	return Network{}, false
+}
+
+// String returns name of the network.
+func (n Network) String() string {
+	return n.Name
+}
+
+// Verify checks that genesis belongs to the network.
+func (n Network) Verify(g *Genesis) error {
+	if g == nil {
+		return fmt.Errorf("%w: genesis is required", ErrNetworkMismatch)
+	}
+	if magic := g.NetworkMagic.IntPart(); magic != int64(n.Magic) {
+		return fmt.Errorf("%w: expected %s network magic %d got %d", ErrNetworkMismatch, n.Name, n.Magic, magic)
+	}
+	if id := genesisNetworkID(g); id != n.NetworkID {
+		return fmt.Errorf("%w: expected %s network id %d got %d (%s)",
+			ErrNetworkMismatch, n.Name, n.NetworkID, id, g.NetworkID)
+	}
+	if !n.SystemStart.IsZero() && !g.SystemStart.IsZero() && !n.SystemStart.Equal(g.SystemStart.Time) {
+		return fmt.Errorf("%w: expected %s system start %s got %s",
+			ErrNetworkMismatch, n.Name, n.SystemStart, g.SystemStart.Time)
+	}
+	return nil
+}
+
+// DetectNetwork returns network profile of the Koios instance used by client.
+// Unknown networks are described by magic and network id from genesis.
+func (c *Client) DetectNetwork(ctx context.Context, opts *RequestOptions) (Network, error) {
+	res, err := c.GetGenesis(ctx, opts)
+	if err != nil {
+		return Network{}, err
+	}
+	magic := uint32(res.Data.NetworkMagic.IntPart())
+	if n, ok := NetworkByMagic(magic); ok {
+		return n, n.Verify(res.Data)
+	}
+	n := Network{
+		Name:        "custom",
+		Host:        c.ServerURL().Host,
+		Magic:       magic,
+		NetworkID:   genesisNetworkID(res.Data),
+		SystemStart: res.Data.SystemStart.Time,
+	}
+	n.AddressHRP, n.StakeHRP = hrpAddrTest, hrpStakeTest
+	if n.NetworkID == NetworkIDMainnet {
+		n.AddressHRP, n.StakeHRP = hrpAddr, hrpStake
+	}
+	return n, nil
+}
+
+// Network returns network profile configured with WithNetwork option.
+func (c *Client) Network() (Network, bool) {
+	cfg := c.config()
+	if cfg.network == nil {
+		return Network{}, false
+	}
+	return *cfg.network, true
+}
+
+// WithNetwork sets host of the network and enables verification of
+// the network. Genesis of the Koios instance is checked against the
+// profile before first request and all requests fail with
+// ErrNetworkMismatch when it does not match.
+// Use Host or BaseURL option after WithNetwork for self-hosted instances.
+func WithNetwork(n Network) Option {
+	return Option{
+		apply: func(c *config) error {
+			if len(n.Host) > 0 {
+				if err := Host(n.Host).apply(c); err != nil {
+					return err
+				}
+			}
+			c.network = &n
+			c.netcheck = &networkCheck{}
+			return nil
+		},
+	}
+}
+
+// verifyNetwork verifies network of the config once.
+// Errors other than network mismatch are not cached.
+func (c *Client) verifyNetwork(ctx context.Context, cfg *config) error {
+	check := cfg.netcheck
+	check.mu.Lock()
+	defer check.mu.Unlock()
+	if check.done {
+		return check.err
+	}
+	res := &GenesisResponse{}
+	rsp, err := c.do(ctx, cfg, &res.ResponseMeta, "GET", "/genesis", nil, nil)
+	if err != nil {
+		if rsp != nil {
+			rsp.Body.Close()
+		}
+		return err
+	}
+	genesis := []Genesis{}
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &genesis); err != nil {
+		return err
+	}
+	g, err := firstItem(genesis, "genesis")
+	if err != nil {
+		return err
+	}
+	check.done = true
+	check.err = cfg.network.Verify(g)
+	return check.err
+}
+
+func genesisNetworkID(g *Genesis) NetworkID {
+	if g.NetworkID == "Mainnet" {
+		return NetworkIDMainnet
+	}
+	return NetworkIDTestnet
+}
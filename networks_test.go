@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	koios "github.com/cardano-community/koios-go-client/v5"
+)
+
+// networkFixture holds known on chain values of the network used by live tests.
+type networkFixture struct {
+	Epoch              koios.EpochNo
+	BlockHash          koios.BlockHash
+	TxHashes           []koios.TxHash
+	PoolID             koios.PoolID
+	ScriptHash         koios.ScriptHash
+	DatumHash          koios.DatumHash
+	Addresses          []koios.Address
+	PaymentCredentials []koios.PaymentCredential
+	Accounts           []koios.Address
+	PolicyID           koios.PolicyID
+	AssetName          koios.AssetName
+	AssetHolders       int
+}
+
+// networkFixtures are test fixtures of predefined networks keyed by network name.
+var networkFixtures = map[string]networkFixture{ //nolint: gochecknoglobals
+	koios.Mainnet.Name: {
+		Epoch:      320,
+		BlockHash:  "fb9087c9f1408a7bbd7b022fd294ab565fec8dd3a8ef091567482722a1fa4e30",
+		TxHashes:   []koios.TxHash{"f144a8264acf4bdfe2e1241170969c930d64ab6b0996a4a45237b623f1dd670e", "0b8ba3bed976fa4913f19adc9f6dd9063138db5b4dd29cecde369456b5155e94"},
+		PoolID:     "pool155efqn9xpcf73pphkk88cmlkdwx4ulkg606tne970qswczg3asc",
+		ScriptHash: "d8480dc869b94b80e81ec91b0abe307279311fe0e7001a9488f61ff8",
+		DatumHash:  "818ee3db3bbbd04f9f2ce21778cac3ac605802a4fcb00c8b3a58ee2dafc17d46",
+		Addresses: []koios.Address{
+			"addr1qyp9kz50sh9c53hpmk3l4ewj9ur794t2hdqpngsjn3wkc5sztv9glpwt3frwrhdrltjaytc8ut2k4w6qrx3p98zad3fq07xe9g",
+			"addr1qyfldpcvte8nkfpyv0jdc8e026cz5qedx7tajvupdu2724tlj8sypsq6p90hl40ya97xamkm9fwsppus2ru8zf6j8g9sm578cu",
+		},
+		PaymentCredentials: []koios.PaymentCredential{
+			"025b0a8f85cb8a46e1dda3fae5d22f07e2d56abb4019a2129c5d6c52",
+			"13f6870c5e4f3b242463e4dc1f2f56b02a032d3797d933816f15e555",
+		},
+		Accounts: []koios.Address{
+			"stake1uyfmzu5qqy70a8kq4c8rw09q0w0ktfcxppwujejnsh6tyrg5c774g",
+			"stake1uydhlh7f2kkw9eazct5zyzlrvj32gjnkmt2v5qf6t8rut4qwch8ey",
+		},
+		PolicyID:     "d3501d9531fcc25e3ca4b6429318c2cc374dbdbcf5e99c1c1e5da1ff",
+		AssetName:    "444f4e545350414d",
+		AssetHolders: 63487,
+	},
+	koios.PreProd.Name: {
+		Epoch:      31,
+		BlockHash:  "2abeb8d1c1227139763be30ddb7a2fd79abd7d44195fca87a7c836a510b2802d",
+		TxHashes:   []koios.TxHash{"d10133964da9e443b303917fd0b7644ae3d01c133deff85b4f59416c2d00f530", "145688d3619e7524510ea64c0ec6363b77a9b8da179ef9bb0273a0940d57d576"},
+		PoolID:     "pool1ext7qrwjzaxcdfhdnkq5mth59ukuu2atcg6tgqpmevpt7ratkta",
+		ScriptHash: "590555d7b5760e98ae2bdd29b356247776251dfab0a207bfce98a485",
+		DatumHash:  "5571e2c3549f15934a38382d1318707a86751fb70827f4cbd29b104480f1be9b",
+		Addresses: []koios.Address{
+			"addr_test1vzpwq95z3xyum8vqndgdd9mdnmafh3djcxnc6jemlgdmswcve6tkw",
+			"addr_test1vpfwv0ezc5g8a4mkku8hhy3y3vp92t7s3ul8g778g5yegsgalc6gc",
+		},
+		PaymentCredentials: []koios.PaymentCredential{
+			"b429738bd6cc58b5c7932d001aa2bd05cfea47020a556c8c753d4436",
+			"82e016828989cd9d809b50d6976d9efa9bc5b2c1a78d4b3bfa1bb83b",
+		},
+		Accounts: []koios.Address{
+			"stake_test1urq4rcynzj4uxqc74c852zky7wa6epgmn9r6k3j3gv7502q8jks0l",
+			"stake_test1ur4t5nhceyn2amfuj7z74uxmmj8jf9fmgd2egqw8c98ve3cp2g4wx",
+		},
+		PolicyID:     "c6e65ba7878b2f8ea0ad39287d3e2fd256dc5c4160fc19bdf4c4d87e",
+		AssetName:    "7447454e53",
+		AssetHolders: 50000,
+	},
+	koios.Preview.Name: {
+		Epoch:      12,
+		BlockHash:  "a4504e2495ed03b48be36676f430c54dca0769d29f72ebf18d493abf42d2167b",
+		TxHashes:   []koios.TxHash{"f1592b29b79ae85d753913dd25644c60925a4a0683979faa33832fead4b4bd9c", "206f6da5b0b0de45605a95f5ce7e172be9674550f7dde3838c45cbf24bab8b00"},
+		PoolID:     "pool1p90428kec03mjdya3k4gv5d20w7lmed7ca0snknef5j977l3y8l",
+		ScriptHash: "f758cf422ca0cbed7d9d6fad1eb5a3c70537d62e661ad450dd2a3810",
+		DatumHash:  "6181b3dc623cd8812caf027a3507e9b3095388a7cf3db65983e1fddd3a84c88c",
+		Addresses: []koios.Address{
+			"addr_test1vpfwv0ezc5g8a4mkku8hhy3y3vp92t7s3ul8g778g5yegsgalc6gc",
+			"addr_test1vqneq3v0dqh3x3muv6ee3lt8e5729xymnxuavx6tndcjc2cv24ef9",
+		},
+		PaymentCredentials: []koios.PaymentCredential{
+			"33c378cee41b2e15ac848f7f6f1d2f78155ab12d93b713de898d855f",
+			"52e63f22c5107ed776b70f7b92248b02552fd08f3e747bc745099441",
+		},
+		Accounts: []koios.Address{
+			"stake_test1upv7n2x0lxepkyx8ux2gjt74ecaa39tjgaccxl6hw5fwzngpzf5zt",
+			"stake_test1up6wqzrw2h9vvjy5zfkjn0dwtymy5r29zyhf8fyhm6fat9c2am5hl",
+		},
+		PolicyID:     "065270479316f1d92e00f7f9f095ebeaac9d009c878dc35ce36d3404",
+		AssetName:    "433374",
+		AssetHolders: 50000,
+	},
+	koios.Guild.Name: {
+		Epoch:      1950,
+		BlockHash:  "bddbbc6df0ad09567a513349bafd56d8ec5c8fcd9ee9db12173624b896350d57",
+		TxHashes:   []koios.TxHash{"bf04578d452dd3acb7c70fbac32dc972cb69f932f804171cfb4268f5af0228e7", "63b716064012f858450731cb5f960c100c6cb639ec1ec999b898c604451f116a"},
+		PoolID:     "pool1xc9eywck4e20tydz4yvh5vfe0ep8whawvwz8wqkc9k046a2ypp4",
+		ScriptHash: "160301a01ee86d8e46cbe3aef1e3bf69bfa28c65d5be2dde56a37af8",
+		DatumHash:  "45b0cfc220ceec5b7c1c62c4d4193d38e4eba48e8815729ce75f9c0ab0e4c1c0",
+		Addresses: []koios.Address{
+			"addr_test1qzmtfv43a8ncx6ve92ja6yy25npn9raz9pu5a2tfxsqv9gy9ktf0pu6yu4zjh9r37fzx3h4tsxqdjhu3t4d5ffdsfz9s6ska3z",
+			"addr_test1vq67g5u8ls4vm4wdvs0r8xvsuej66nvaqedyrj2tcz6tuycz275pu",
+		},
+		PaymentCredentials: []koios.PaymentCredential{
+			"b6b4b2b1e9e78369992aa5dd108aa4c3328fa228794ea9693400c2a0",
+			"35e45387fc2acdd5cd641e339990e665ad4d9d065a41c94bc0b4be13",
+		},
+		Accounts: []koios.Address{
+			"stake_test17zt9x005zkd2usz2vhvktyzqsuwz25gmgnaqdka5hcj9m2qfg2py2",
+			"stake_test1uzzm95hs7dzw23ftj3cly3rgm64crqxet7g46k6y5kcy3zcs3mpjd",
+		},
+		PolicyID:     "313534a537bc476c86ff7c57ec511bd7f24a9d15654091b24e9c606e",
+		AssetName:    "41484c636f696e",
+		AssetHolders: 63487,
+	},
+}
+
+// testNetwork returns network selected with KOIOS_NETWORK and its fixtures.
+// It returns false when KOIOS_NETWORK is not set or network is unknown,
+// in which case fixtures are empty.
+func testNetwork() (koios.Network, networkFixture, bool) {
+	n, ok := koios.NetworkByName(os.Getenv("KOIOS_NETWORK"))
+	if !ok {
+		return koios.Network{}, networkFixture{}, false
+	}
+	fx, ok := networkFixtures[n.Name]
+	return n, fx, ok
+}
+
+func TestNetworkFixtures(t *testing.T) {
+	for _, n := range koios.Networks() {
+		t.Run(n.Name, func(t *testing.T) {
+			fx, ok := networkFixtures[n.Name]
+			if !ok {
+				t.Fatal("missing fixtures")
+			}
+			for _, addr := range append(append([]koios.Address{}, fx.Addresses...), fx.Accounts...) {
+				d, err := addr.Decode()
+				if err != nil {
+					t.Errorf("%s: %v", addr, err)
+					continue
+				}
+				if d.NetworkID != n.NetworkID || (d.HRP != n.AddressHRP && d.HRP != n.StakeHRP) {
+					t.Errorf("%s: address is not on %s network", addr, n.Name)
+				}
+			}
+			if !fx.PoolID.Valid() {
+				t.Errorf("invalid pool id %s", fx.PoolID)
+			}
+			if _, err := fx.BlockHash.Valid(); err != nil {
+				t.Error(err)
+			}
+			for _, tx := range fx.TxHashes {
+				if _, err := tx.Valid(); err != nil {
+					t.Error(err)
+				}
+			}
+			if _, err := fx.ScriptHash.Valid(); err != nil {
+				t.Error(err)
+			}
+			if _, err := fx.DatumHash.Valid(); err != nil {
+				t.Error(err)
+			}
+			if _, err := fx.PolicyID.Valid(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// closeTracker counts closed response bodies.
+type closeTracker struct {
+	rt     http.RoundTripper
+	closed atomic.Int32
+}
+
+func (ct *closeTracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	rsp, err := ct.rt.RoundTrip(req)
+	if rsp != nil {
+		rsp.Body = &trackedBody{ReadCloser: rsp.Body, ct: ct}
+	}
+	return rsp, err
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	ct *closeTracker
+}
+
+func (b *trackedBody) Close() error {
+	b.ct.closed.Add(1)
+	return b.ReadCloser.Close()
+}
+
+func TestNetworkVerification(t *testing.T) {
+	network := koios.Network{Name: "test", Magic: 42, NetworkID: koios.NetworkIDMainnet}
+	tests := []struct {
+		name string
+		// genesis responses in order, status 0 means OK.
+		statuses []int
+		genesis  string
+		// errors of subsequent GetTip calls, nil means success.
+		errs          []error
+		genesisHits   int32
+		tipHits       int32
+		closedOnError bool
+	}{
+		{
+			name:        "magic mismatch cached",
+			genesis:     `[{"networkmagic":"2","networkid":"Mainnet"}]`,
+			errs:        []error{koios.ErrNetworkMismatch, koios.ErrNetworkMismatch},
+			genesisHits: 1,
+		},
+		{
+			name:        "network id mismatch cached",
+			genesis:     `[{"networkmagic":"42","networkid":"Testnet"}]`,
+			errs:        []error{koios.ErrNetworkMismatch, koios.ErrNetworkMismatch},
+			genesisHits: 1,
+		},
+		{
+			name:        "success cached",
+			genesis:     `[{"networkmagic":"42","networkid":"Mainnet"}]`,
+			errs:        []error{nil, nil},
+			genesisHits: 1,
+			tipHits:     2,
+		},
+		{
+			name:          "transient failure not cached",
+			statuses:      []int{http.StatusServiceUnavailable},
+			genesis:       `[{"networkmagic":"42","networkid":"Mainnet"}]`,
+			errs:          []error{koios.ErrResponse, nil},
+			genesisHits:   2,
+			tipHits:       1,
+			closedOnError: true,
+		},
+		{
+			name:        "empty genesis not cached",
+			genesis:     `[]`,
+			errs:        []error{koios.ErrNoData, koios.ErrNoData},
+			genesisHits: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var genesisHits, tipHits atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/v1/genesis":
+					n := int(genesisHits.Add(1))
+					if n <= len(tt.statuses) && tt.statuses[n-1] != 0 {
+						w.WriteHeader(tt.statuses[n-1])
+						return
+					}
+					_, _ = w.Write([]byte(tt.genesis))
+				case "/api/v1/tip":
+					tipHits.Add(1)
+					_, _ = w.Write([]byte(`[{"epoch_no":1}]`))
+				}
+			}))
+			defer srv.Close()
+
+			ct := &closeTracker{rt: http.DefaultTransport}
+			c, err := koios.New(
+				koios.HTTPClient(&http.Client{Transport: ct, Timeout: time.Minute}),
+				koios.WithNetwork(network),
+				koios.BaseURL(srv.URL+"/api/v1/"),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, want := range tt.errs {
+				_, err := c.GetTip(context.Background(), nil)
+				if (want == nil && err != nil) || !errors.Is(err, want) {
+					t.Errorf("call %d: expected %v got %v", i, want, err)
+				}
+				if i == 0 && tt.closedOnError && ct.closed.Load() == 0 {
+					t.Error("response body of failed genesis request is not closed")
+				}
+			}
+			if got := genesisHits.Load(); got != tt.genesisHits {
+				t.Errorf("expected %d genesis requests got %d", tt.genesisHits, got)
+			}
+			if got := tipHits.Load(); got != tt.tipHits {
+				t.Errorf("expected %d tip requests got %d", tt.tipHits, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// OgmiosClient provides typed access to subset of Ogmios JSON-RPC
+	// methods proxied by Koios at `/ogmios` endpoint.
+	OgmiosClient struct {
+		c *Client
+	}
+
+	// OgmiosPoint is point on chain identified by slot and block header hash.
+	OgmiosPoint struct {
+		Slot Slot   `json:"slot"`
+		ID   string `json:"id"`
+	}
+
+	// OgmiosLovelace is ada amount in Ogmios value format.
+	OgmiosLovelace struct {
+		Ada struct {
+			Lovelace decimal.Decimal `json:"lovelace"`
+		} `json:"ada"`
+	}
+
+	// OgmiosBytes is size in bytes.
+	OgmiosBytes struct {
+		Bytes uint64 `json:"bytes"`
+	}
+
+	// OgmiosExUnits execution units of the script.
+	OgmiosExUnits struct {
+		Memory uint64 `json:"memory"`
+		CPU    uint64 `json:"cpu"`
+	}
+
+	// OgmiosBound is start or end of the era.
+	OgmiosBound struct {
+		Time struct {
+			Seconds uint64 `json:"seconds"`
+		} `json:"time"`
+		Slot  Slot    `json:"slot"`
+		Epoch EpochNo `json:"epoch"`
+	}
+
+	// OgmiosEraSummary is summary of the era boundaries and slotting parameters.
+	OgmiosEraSummary struct {
+		Start OgmiosBound `json:"start"`
+		// End of the era, nil for current era.
+		End        *OgmiosBound `json:"end"`
+		Parameters struct {
+			EpochLength uint64 `json:"epochLength"`
+			SlotLength  struct {
+				Milliseconds uint64 `json:"milliseconds"`
+			} `json:"slotLength"`
+			SafeZone uint64 `json:"safeZone"`
+		} `json:"parameters"`
+	}
+
+	// OgmiosProtocolParameters current protocol parameters.
+	// Ratios are reported as "numerator/denominator" strings.
+	OgmiosProtocolParameters struct {
+		MinFeeCoefficient      uint64         `json:"minFeeCoefficient"`
+		MinFeeConstant         OgmiosLovelace `json:"minFeeConstant"`
+		MinFeeReferenceScripts *struct {
+			Range      uint64  `json:"range"`
+			Base       float64 `json:"base"`
+			Multiplier float64 `json:"multiplier"`
+		} `json:"minFeeReferenceScripts,omitempty"`
+		MaxBlockBodySize                OgmiosBytes        `json:"maxBlockBodySize"`
+		MaxBlockHeaderSize              OgmiosBytes        `json:"maxBlockHeaderSize"`
+		MaxTransactionSize              OgmiosBytes        `json:"maxTransactionSize"`
+		MaxValueSize                    OgmiosBytes        `json:"maxValueSize"`
+		MaxReferenceScriptsSize         *OgmiosBytes       `json:"maxReferenceScriptsSize,omitempty"`
+		StakeCredentialDeposit          OgmiosLovelace     `json:"stakeCredentialDeposit"`
+		StakePoolDeposit                OgmiosLovelace     `json:"stakePoolDeposit"`
+		StakePoolRetirementEpochBound   uint64             `json:"stakePoolRetirementEpochBound"`
+		DesiredNumberOfStakePools       uint64             `json:"desiredNumberOfStakePools"`
+		StakePoolPledgeInfluence        string             `json:"stakePoolPledgeInfluence"`
+		MonetaryExpansion               string             `json:"monetaryExpansion"`
+		TreasuryExpansion               string             `json:"treasuryExpansion"`
+		MinStakePoolCost                OgmiosLovelace     `json:"minStakePoolCost"`
+		MinUTxODepositCoefficient       uint64             `json:"minUtxoDepositCoefficient"`
+		PlutusCostModels                map[string][]int64 `json:"plutusCostModels"`
+		ScriptExecutionPrices           map[string]string  `json:"scriptExecutionPrices"`
+		MaxExecutionUnitsPerTransaction OgmiosExUnits      `json:"maxExecutionUnitsPerTransaction"`
+		MaxExecutionUnitsPerBlock       OgmiosExUnits      `json:"maxExecutionUnitsPerBlock"`
+		MaxCollateralInputs             uint64             `json:"maxCollateralInputs"`
+		CollateralPercentage            uint64             `json:"collateralPercentage"`
+		GovernanceActionDeposit         *OgmiosLovelace    `json:"governanceActionDeposit,omitempty"`
+		GovernanceActionLifetime        uint64             `json:"governanceActionLifetime,omitempty"`
+		DelegateRepresentativeDeposit   *OgmiosLovelace    `json:"delegateRepresentativeDeposit,omitempty"`
+		DelegateRepresentativeMaxIdle   uint64             `json:"delegateRepresentativeMaxIdleTime,omitempty"`
+		ConstitutionalCommitteeMinSize  uint64             `json:"constitutionalCommitteeMinSize,omitempty"`
+		ConstitutionalCommitteeMaxTerm  uint64             `json:"constitutionalCommitteeMaxTermLength,omitempty"`
+		Version                         struct {
+			Major int `json:"major"`
+			Minor int `json:"minor"`
+		} `json:"version"`
+	}
+
+	// OgmiosStakeDistribution live stake of the pool.
+	OgmiosStakeDistribution struct {
+		// Stake relative stake of the pool as "numerator/denominator".
+		Stake string `json:"stake"`
+		// VRF verification key hash of the pool.
+		VRF string `json:"vrf"`
+	}
+
+	// OgmiosStakePool registered stake pool parameters.
+	OgmiosStakePool struct {
+		ID                     PoolID         `json:"id"`
+		VRFVerificationKeyHash string         `json:"vrfVerificationKeyHash"`
+		Owners                 []string       `json:"owners"`
+		Cost                   OgmiosLovelace `json:"cost"`
+		Margin                 string         `json:"margin"`
+		Pledge                 OgmiosLovelace `json:"pledge"`
+		RewardAccount          Address        `json:"rewardAccount"`
+		Metadata               *struct {
+			URL  string `json:"url"`
+			Hash string `json:"hash"`
+		} `json:"metadata,omitempty"`
+		Relays []json.RawMessage `json:"relays"`
+	}
+
+	// OgmiosValidator identifies redeemer by its purpose and index.
+	OgmiosValidator struct {
+		// Purpose of the redeemer (spend | mint | publish | withdraw | vote | propose).
+		Purpose string `json:"purpose"`
+		// Index of the redeemer within its purpose.
+		Index uint32 `json:"index"`
+	}
+
+	// OgmiosEvaluation execution units required by single redeemer.
+	OgmiosEvaluation struct {
+		Validator OgmiosValidator `json:"validator"`
+		Budget    OgmiosExUnits   `json:"budget"`
+	}
+
+	// OgmiosSubmitResult result of submitted transaction.
+	OgmiosSubmitResult struct {
+		Transaction struct {
+			ID TxHash `json:"id"`
+		} `json:"transaction"`
+	}
+
+	// OgmiosError is JSON-RPC error returned by Ogmios.
+	OgmiosError struct {
+		Code    int             `json:"code"`
+		Message string          `json:"message"`
+		Data    json.RawMessage `json:"data,omitempty"`
+	}
+
+	ogmiosRequest struct {
+		JSONRPC string `json:"jsonrpc"`
+		Method  string `json:"method"`
+		Params  any    `json:"params,omitempty"`
+	}
+
+	ogmiosResponse[T any] struct {
+		Result T            `json:"result"`
+		Error  *OgmiosError `json:"error,omitempty"`
+	}
+
+	ogmiosTx struct {
+		Transaction struct {
+			CBOR string `json:"cbor"`
+		} `json:"transaction"`
+	}
+)
+
+// Ogmios returns client for Ogmios JSON-RPC methods proxied by Koios.
+func (c *Client) Ogmios() *OgmiosClient {
+	return &OgmiosClient{c: c}
+}
+
+// NetworkBlockHeight returns chain's highest block number.
+func (o *OgmiosClient) NetworkBlockHeight(ctx context.Context, opts *RequestOptions) (*Response[uint64], error) {
+	return ogmiosCall[uint64](ctx, o, "queryNetwork/blockHeight", nil, opts)
+}
+
+// NetworkGenesisConfiguration returns genesis configuration of given era
+// (byron | shelley | alonzo | conway).
+func (o *OgmiosClient) NetworkGenesisConfiguration(
+	ctx context.Context,
+	era string,
+	opts *RequestOptions,
+) (*Response[json.RawMessage], error) {
+	params := struct {
+		Era string `json:"era"`
+	}{era}
+	return ogmiosCall[json.RawMessage](ctx, o, "queryNetwork/genesisConfiguration", params, opts)
+}
+
+// NetworkStartTime returns chain's start time (UTC).
+func (o *OgmiosClient) NetworkStartTime(ctx context.Context, opts *RequestOptions) (*Response[time.Time], error) {
+	return ogmiosCall[time.Time](ctx, o, "queryNetwork/startTime", nil, opts)
+}
+
+// NetworkTip returns network's tip.
+func (o *OgmiosClient) NetworkTip(ctx context.Context, opts *RequestOptions) (*Response[OgmiosPoint], error) {
+	return ogmiosCall[OgmiosPoint](ctx, o, "queryNetwork/tip", nil, opts)
+}
+
+// LedgerEpoch returns current epoch of the ledger.
+func (o *OgmiosClient) LedgerEpoch(ctx context.Context, opts *RequestOptions) (*Response[EpochNo], error) {
+	return ogmiosCall[EpochNo](ctx, o, "queryLedgerState/epoch", nil, opts)
+}
+
+// LedgerEraStart returns information about start of the current era.
+func (o *OgmiosClient) LedgerEraStart(ctx context.Context, opts *RequestOptions) (*Response[OgmiosBound], error) {
+	return ogmiosCall[OgmiosBound](ctx, o, "queryLedgerState/eraStart", nil, opts)
+}
+
+// LedgerEraSummaries returns era bounds and slotting parameters of all eras.
+func (o *OgmiosClient) LedgerEraSummaries(
+	ctx context.Context,
+	opts *RequestOptions,
+) (*Response[[]OgmiosEraSummary], error) {
+	return ogmiosCall[[]OgmiosEraSummary](ctx, o, "queryLedgerState/eraSummaries", nil, opts)
+}
+
+// LedgerLiveStakeDistribution returns live stake distribution keyed by pool id.
+func (o *OgmiosClient) LedgerLiveStakeDistribution(
+	ctx context.Context,
+	opts *RequestOptions,
+) (*Response[map[PoolID]OgmiosStakeDistribution], error) {
+	return ogmiosCall[map[PoolID]OgmiosStakeDistribution](ctx, o, "queryLedgerState/liveStakeDistribution", nil, opts)
+}
+
+// LedgerProtocolParameters returns current protocol parameters.
+func (o *OgmiosClient) LedgerProtocolParameters(
+	ctx context.Context,
+	opts *RequestOptions,
+) (*Response[OgmiosProtocolParameters], error) {
+	return ogmiosCall[OgmiosProtocolParameters](ctx, o, "queryLedgerState/protocolParameters", nil, opts)
+}
+
+// LedgerProposedProtocolParameters returns protocol parameters proposed for next epoch.
+func (o *OgmiosClient) LedgerProposedProtocolParameters(
+	ctx context.Context,
+	opts *RequestOptions,
+) (*Response[[]OgmiosProtocolParameters], error) {
+	return ogmiosCall[[]OgmiosProtocolParameters](ctx, o, "queryLedgerState/proposedProtocolParameters", nil, opts)
+}
+
+// LedgerStakePools returns parameters of given stake pools
+// or all registered stake pools when pids is empty.
+func (o *OgmiosClient) LedgerStakePools(
+	ctx context.Context,
+	pids []PoolID,
+	opts *RequestOptions,
+) (*Response[map[PoolID]OgmiosStakePool], error) {
+	var params any
+	if len(pids) > 0 {
+		var err error
+		if pids, err = bech32PoolIDs(pids); err != nil {
+			res := &Response[map[PoolID]OgmiosStakePool]{}
+			res.RequestMethod = "POST"
+			res.applyError(nil, err)
+			return res, err
+		}
+		type pool struct {
+			ID PoolID `json:"id"`
+		}
+		pools := make([]pool, len(pids))
+		for i, pid := range pids {
+			pools[i].ID = pid
+		}
+		params = struct {
+			StakePools []pool `json:"stakePools"`
+		}{pools}
+	}
+	return ogmiosCall[map[PoolID]OgmiosStakePool](ctx, o, "queryLedgerState/stakePools", params, opts)
+}
+
+// EvaluateTransaction evaluates execution units of scripts in given transaction
+// and returns execution budget required by each redeemer.
+func (o *OgmiosClient) EvaluateTransaction(
+	ctx context.Context,
+	tx TxBodyJSON,
+	opts *RequestOptions,
+) (*Response[[]OgmiosEvaluation], error) {
+	params, err := ogmiosTxParams(tx)
+	if err != nil {
+		res := &Response[[]OgmiosEvaluation]{}
+		res.RequestMethod = "POST"
+		res.applyError(nil, err)
+		return res, err
+	}
+	return ogmiosCall[[]OgmiosEvaluation](ctx, o, "evaluateTransaction", params, opts)
+}
+
+// SubmitTransaction submits signed transaction to the network.
+func (o *OgmiosClient) SubmitTransaction(
+	ctx context.Context,
+	tx TxBodyJSON,
+	opts *RequestOptions,
+) (*Response[OgmiosSubmitResult], error) {
+	params, err := ogmiosTxParams(tx)
+	if err != nil {
+		res := &Response[OgmiosSubmitResult]{}
+		res.RequestMethod = "POST"
+		res.applyError(nil, err)
+		return res, err
+	}
+	return ogmiosCall[OgmiosSubmitResult](ctx, o, "submitTransaction", params, opts)
+}
+
+// Error returns JSON-RPC error message.
+func (e *OgmiosError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+func ogmiosTxParams(tx TxBodyJSON) (ogmiosTx, error) {
+	params := ogmiosTx{}
+	if _, err := hex.DecodeString(tx.CborHex); err != nil {
+		return params, fmt.Errorf("%w: %s", ErrOgmios, err.Error())
+	}
+	params.Transaction.CBOR = tx.CborHex
+	return params, nil
+}
+
+// ogmiosCall sends JSON-RPC request through Koios `/ogmios` endpoint.
+// JSON-RPC errors are mapped into ResponseError wrapping ErrOgmios
+// with Code set to JSON-RPC error code.
+func ogmiosCall[T any](
+	ctx context.Context,
+	o *OgmiosClient,
+	method string,
+	params any,
+	opts *RequestOptions,
+) (*Response[T], error) {
+	res := &Response[T]{}
+	body, err := requestPayload(ogmiosRequest{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		res.RequestMethod = "POST"
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := o.c.request(ctx, &res.ResponseMeta, "POST", "/ogmios", body, opts)
+	if rsp == nil {
+		return res, err
+	}
+	b, rerr := ReadResponseBody(rsp)
+	if rerr != nil {
+		res.applyError(nil, rerr)
+		return res, rerr
+	}
+
+	rpc := ogmiosResponse[T]{}
+	if jerr := json.Unmarshal(b, &rpc); jerr != nil {
+		if err == nil {
+			err = jerr
+		}
+		res.applyError(b, err)
+		return res, err
+	}
+	if rpc.Error != nil {
+		res.Error = &ResponseError{
+			error:   ErrOgmios,
+			Code:    ErrorCodeFromInt(rpc.Error.Code),
+			Message: fmt.Sprintf("%s: %s", ErrOgmios.Error(), rpc.Error.Message),
+			Details: string(rpc.Error.Data),
+		}
+		res.ready()
+		return res, res.Error
+	}
+	if err != nil {
+		res.applyError(b, err)
+		return res, err
+	}
+	res.Data = rpc.Result
+	return res, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOgmiosCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/ogmios" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		var req struct {
+			JSONRPC string          `json:"jsonrpc"`
+			Method  string          `json:"method"`
+			Params  json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		if req.JSONRPC != "2.0" {
+			t.Errorf("expected jsonrpc 2.0 got %q", req.JSONRPC)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "queryLedgerState/epoch":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","method":"queryLedgerState/epoch","result":500}`))
+		case "evaluateTransaction":
+			if string(req.Params) != `{"transaction":{"cbor":"84a0a0f5f6"}}` {
+				t.Errorf("unexpected params %s", req.Params)
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","method":"evaluateTransaction","result":[` +
+				`{"validator":{"purpose":"spend","index":1},"budget":{"memory":5236,"cpu":1212344}}]}`))
+		case "submitTransaction":
+			// Koios proxies JSON-RPC errors with 400 status.
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","method":"submitTransaction","error":` +
+				`{"code":3117,"message":"The transaction contains unknown UTxO references as inputs.",` +
+				`"data":[{"index":0,"transaction":{"id":"` + validTxHash + `"}}]}}`))
+		case "queryNetwork/tip":
+			// error envelope with success status.
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","method":"queryNetwork/tip","error":` +
+				`{"code":-32601,"message":"Method not found"}}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>bad gateway</html>`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(BaseURL(srv.URL + "/api/v1/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	tx := TxBodyJSON{CborHex: "84a0a0f5f6"}
+
+	t.Run("result", func(t *testing.T) {
+		res, err := c.Ogmios().LedgerEpoch(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Data != 500 || res.Error != nil || res.StatusCode != http.StatusOK {
+			t.Errorf("unexpected response %d %v %d", res.Data, res.Error, res.StatusCode)
+		}
+
+		eval, err := c.Ogmios().EvaluateTransaction(ctx, tx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := OgmiosEvaluation{
+			Validator: OgmiosValidator{Purpose: "spend", Index: 1},
+			Budget:    OgmiosExUnits{Memory: 5236, CPU: 1212344},
+		}
+		if len(eval.Data) != 1 || eval.Data[0] != want {
+			t.Errorf("expected %+v got %+v", want, eval.Data)
+		}
+	})
+
+	errTests := []struct {
+		name   string
+		call   func() (*ResponseError, error)
+		code   ErrorCode
+		status int
+	}{
+		{
+			name: "error envelope with error status",
+			call: func() (*ResponseError, error) {
+				res, err := c.Ogmios().SubmitTransaction(ctx, tx, nil)
+				return res.Error, err
+			},
+			code:   "3117",
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "error envelope with success status",
+			call: func() (*ResponseError, error) {
+				res, err := c.Ogmios().NetworkTip(ctx, nil)
+				return res.Error, err
+			},
+			code:   "-32601",
+			status: http.StatusOK,
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rerr, err := tt.call()
+			if !errors.Is(err, ErrOgmios) {
+				t.Fatalf("expected ErrOgmios got %v", err)
+			}
+			var target *ResponseError
+			if !errors.As(err, &target) || target != rerr {
+				t.Fatalf("expected *ResponseError of the response got %T", err)
+			}
+			if rerr.Code != tt.code {
+				t.Errorf("expected code %s got %s", tt.code, rerr.Code)
+			}
+			if rerr.Message == "" || rerr.Message == ErrOgmios.Error() {
+				t.Errorf("expected JSON-RPC message got %q", rerr.Message)
+			}
+		})
+	}
+
+	t.Run("error data", func(t *testing.T) {
+		res, _ := c.Ogmios().SubmitTransaction(ctx, tx, nil)
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("expected status 400 got %d", res.StatusCode)
+		}
+		if want := `[{"index":0,"transaction":{"id":"` + validTxHash + `"}}]`; res.Error.Details != want {
+			t.Errorf("expected details %s got %s", want, res.Error.Details)
+		}
+	})
+
+	t.Run("not json-rpc response", func(t *testing.T) {
+		_, err := c.Ogmios().LedgerEraStart(ctx, nil)
+		if !errors.Is(err, ErrResponse) || errors.Is(err, ErrOgmios) {
+			t.Errorf("expected ErrResponse got %v", err)
+		}
+	})
+
+	t.Run("invalid tx", func(t *testing.T) {
+		nc, nsrv := newNoHitClient(t)
+		defer nsrv.Close()
+		res, err := nc.Ogmios().SubmitTransaction(ctx, TxBodyJSON{CborHex: "zz"}, nil)
+		if !errors.Is(err, ErrOgmios) || res.Error == nil {
+			t.Errorf("expected ErrOgmios got %v", err)
+		}
+	})
+}
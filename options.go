@@ -5,9 +5,15 @@
 package koios
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -17,19 +23,20 @@ type (
 	// Option is callback function to apply
 	// configurations options of API Client.
 	Option struct {
-		apply func(*Client) error
+		apply func(*config) error
 	}
 )
 
 // Host returns option apply func which can be used to change the
 // baseurl hostname https://<host>/api/v0/
+// Host may include port e.g. localhost:8080.
 func Host(host string) Option {
 	return Option{
-		apply: func(c *Client) error {
-			if c.url.Port() == "" || c.url.Port() == "80" || c.url.Port() == "443" {
+		apply: func(c *config) error {
+			if strings.Contains(host, ":") || c.url.Port() == "" {
 				c.url.Host = host
 			} else {
-				c.url.Host = fmt.Sprint(host, ":", c.url.Port())
+				c.url.Host = net.JoinHostPort(host, c.url.Port())
 			}
 			return nil
 		},
@@ -38,24 +45,73 @@ func Host(host string) Option {
 
 // APIVersion returns option to apply change of the
 // baseurl api version https://api.koios.rest/api/<version>/
+// When base url has custom path prefix set with BaseURL option,
+// /api/<version>/ is appended to the prefix or version segment is replaced.
 func APIVersion(version string) Option {
 	return Option{
-		apply: func(c *Client) error {
-			url, err := c.url.Parse("/api/" + version + "/")
+		apply: func(c *config) error {
+			prefix := c.url.Path
+			if i := strings.LastIndex(prefix, "/api/"); i >= 0 {
+				prefix = prefix[:i+1]
+			}
+			if !strings.HasSuffix(prefix, "/") {
+				prefix += "/"
+			}
+			url, err := c.url.Parse(prefix + "api/" + version + "/")
+			if err != nil {
+				return err
+			}
 			c.url = url
-			return err
+			return nil
 		},
 	}
 }
 
 // Port returns option apply func which can be used to change the
 // baseurl port https://api.koios.rest:<port>/api/v0/
+// Port 0 or default port of the scheme removes explicit port from base url.
 func Port(port uint16) Option {
 	return Option{
-		apply: func(c *Client) error {
-			if port >= 80 && port != 443 {
-				c.url.Host = fmt.Sprint(c.url.Hostname(), ":", port)
+		apply: func(c *config) error {
+			if port == 0 ||
+				(port == 80 && c.url.Scheme == "http") ||
+				(port == 443 && c.url.Scheme == "https") {
+				c.url.Host = c.url.Hostname()
+				if strings.Contains(c.url.Host, ":") {
+					// ipv6
+					c.url.Host = "[" + c.url.Host + "]"
+				}
+				return nil
 			}
+			c.url.Host = net.JoinHostPort(c.url.Hostname(), strconv.Itoa(int(port)))
+			return nil
+		},
+	}
+}
+
+// BaseURL sets full base url used for requests. It is useful when using
+// self-hosted Koios or PostgREST instance e.g. behind reverse proxy
+// with custom path prefix https://example.com/koios/api/v1/.
+// Endpoint paths are resolved relative to provided url.
+func BaseURL(baseurl string) Option {
+	return Option{
+		apply: func(c *config) error {
+			u, err := url.ParseRequestURI(baseurl)
+			if err != nil {
+				return fmt.Errorf("%w: %s", ErrURL, err.Error())
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return ErrSchema
+			}
+			if u.Host == "" {
+				return fmt.Errorf("%w: missing host", ErrURL)
+			}
+			if !strings.HasSuffix(u.Path, "/") {
+				u.Path += "/"
+			}
+			u.RawQuery = ""
+			u.Fragment = ""
+			c.url = u
 			return nil
 		},
 	}
@@ -65,7 +121,7 @@ func Port(port uint16) Option {
 // baseurl scheme <scheme>://api.koios.rest/api/v0/.
 func Scheme(scheme string) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			c.url.Scheme = scheme
 			if scheme != "http" && scheme != "https" {
 				return ErrSchema
@@ -76,9 +132,11 @@ func Scheme(scheme string) Option {
 }
 
 // HTTPClient enables to set htt.Client to be used for requests.
+// TLS options are applied to its transport regardless of order of options,
+// transport must be *http.Transport when TLS options are used.
 func HTTPClient(client *http.Client) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			if c.locked {
 				return ErrClientLocked
 			}
@@ -97,11 +155,11 @@ func HTTPClient(client *http.Client) Option {
 			}
 			c.client = client
 			if c.client.Transport == nil {
-				t := http.DefaultTransport.(*http.Transport).Clone()
-				t.MaxIdleConns = 100
-				t.MaxConnsPerHost = 100
-				t.MaxIdleConnsPerHost = 100
-				c.client.Transport = t
+				c.client.Transport = defaultTransport()
+			}
+			if len(c.tls) > 0 {
+				// reapply TLS options provided before the client.
+				return c.modifyTLSConfig(c.tls...)
 			}
 			return nil
 		},
@@ -113,7 +171,7 @@ func HTTPClient(client *http.Client) Option {
 // Let's respect usage of the community provided resources.
 func RateLimit(reqps int) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			if reqps == 0 {
 				return ErrRateLimitRange
 			}
@@ -134,7 +192,7 @@ func RateLimit(reqps int) Option {
 // can provide HA services for Cardano ecosystem.
 func Origin(origin string) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			o, err := url.ParseRequestURI(origin)
 			if err != nil {
 				return err
@@ -158,21 +216,171 @@ func CollectRequestsStats(enabled bool) Option {
 // to collect detailed timing information about the request.
 func EnableRequestsStats(enable bool) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			c.reqStatsEnabled = enable
 			return nil
 		},
 	}
 }
 
+// Timeout sets timeout of http.Client used for requests.
 func Timeout(timeout time.Duration) Option {
 	return Option{
-		apply: func(c *Client) error {
+		apply: func(c *config) error {
 			if c.client == nil {
 				return ErrHTTPClientNotSet
 			}
-			c.client.Timeout = timeout
+			if timeout == 0 {
+				return ErrHTTPClientTimeoutSetting
+			}
+			// http.Client may be in use by requests in flight,
+			// so modify the copy of it.
+			client := *c.client
+			client.Timeout = timeout
+			c.client = &client
+			return nil
+		},
+	}
+}
+
+// Header sets common header which is sent with every request.
+// It replaces any existing values associated with key.
+func Header(key, val string) Option {
+	return Option{
+		apply: func(c *config) error {
+			c.commonHeaders.Set(key, val)
+			return nil
+		},
+	}
+}
+
+// Auth sets Koios JWT auth token used for requests.
+// Empty token removes currently used token.
+func Auth(jwt string) Option {
+	return Option{
+		apply: func(c *config) error {
+			if jwt == "" {
+				c.auth = &AuthInfo{}
+				return nil
+			}
+			auth, err := GetTokenAuthInfo(jwt)
+			if err != nil {
+				return err
+			}
+			c.auth = &auth
 			return nil
 		},
 	}
 }
+
+// TLSRootCAs sets pool of root certificate authorities used to verify
+// server certificates e.g. when self-hosted instance uses private CA.
+func TLSRootCAs(pool *x509.CertPool) Option {
+	return Option{
+		apply: func(c *config) error {
+			return c.addTLSConfig(func(tc *tls.Config) {
+				tc.RootCAs = pool
+			})
+		},
+	}
+}
+
+// TLSClientCertificate sets client certificates presented
+// to the server when it requires mutual TLS (mTLS).
+func TLSClientCertificate(certs ...tls.Certificate) Option {
+	return Option{
+		apply: func(c *config) error {
+			return c.addTLSConfig(func(tc *tls.Config) {
+				tc.Certificates = certs
+			})
+		},
+	}
+}
+
+// TLSInsecureSkipVerify disables verification of server certificates.
+// It should only be used for local development and testing.
+func TLSInsecureSkipVerify(skip bool) Option {
+	return Option{
+		apply: func(c *config) error {
+			return c.addTLSConfig(func(tc *tls.Config) {
+				tc.InsecureSkipVerify = skip //nolint: gosec
+			})
+		},
+	}
+}
+
+// AuthHeader sets static auth header sent with every request.
+// It can be used with gateways which use API key headers or
+// other auth schemes instead of Koios JWT.
+// When header is Authorization it is used instead of Koios JWT auth token.
+// Empty value removes previously set auth header.
+func AuthHeader(header, value string) Option {
+	return Option{
+		apply: func(c *config) error {
+			if value == "" {
+				c.authHeader = nil
+				return nil
+			}
+			if header == "" {
+				return fmt.Errorf("%w: auth header name is empty", ErrAuth)
+			}
+			c.authHeader = http.Header{}
+			c.authHeader.Set(header, value)
+			return nil
+		},
+	}
+}
+
+// BasicAuth sets static basic auth Authorization header sent with every request.
+func BasicAuth(username, password string) Option {
+	creds := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return AuthHeader("Authorization", "Basic "+creds)
+}
+
+// defaultTransport returns copy of http.DefaultTransport
+// tuned for concurrent requests to single host.
+func defaultTransport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.MaxIdleConns = 100
+	t.MaxConnsPerHost = 100
+	t.MaxIdleConnsPerHost = 100
+	return t
+}
+
+// addTLSConfig records TLS option, so that it is applied also
+// to http.Client set later with HTTPClient option, and applies it
+// to currently used http transport.
+func (c *config) addTLSConfig(modify func(tc *tls.Config)) error {
+	c.tls = append(c.tls, modify)
+	return c.modifyTLSConfig(modify)
+}
+
+// modifyTLSConfig applies changes to the TLS config
+// of the copy of currently used http transport.
+func (c *config) modifyTLSConfig(modifiers ...func(tc *tls.Config)) error {
+	if c.client == nil {
+		return ErrHTTPClientNotSet
+	}
+	var transport *http.Transport
+	switch t := c.client.Transport.(type) {
+	case nil:
+		transport = defaultTransport()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return fmt.Errorf("%w: http.Client.Transport must be *http.Transport to configure TLS", ErrTLSConfig)
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	for _, modify := range modifiers {
+		modify(transport.TLSClientConfig)
+	}
+
+	// http.Client may be in use by requests in flight,
+	// so modify the copy of it.
+	client := *c.client
+	client.Transport = transport
+	c.client = &client
+	return nil
+}
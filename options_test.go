@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestTLSOptionsWithHTTPClient(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{"tls before client", []Option{TLSInsecureSkipVerify(true), HTTPClient(&http.Client{})}},
+		{"tls after client", []Option{HTTPClient(&http.Client{}), TLSInsecureSkipVerify(true)}},
+		{"tls without client", []Option{TLSInsecureSkipVerify(true)}},
+		{
+			"tls before client with transport",
+			[]Option{TLSInsecureSkipVerify(true), HTTPClient(&http.Client{Transport: &http.Transport{}})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			transport, ok := c.config().client.Transport.(*http.Transport)
+			if !ok {
+				t.Fatalf("transport is %T", c.config().client.Transport)
+			}
+			if transport.TLSClientConfig == nil || !transport.TLSClientConfig.InsecureSkipVerify {
+				t.Error("TLS config is not applied")
+			}
+		})
+	}
+}
+
+func TestTLSOptionsDefaultTransport(t *testing.T) {
+	c, err := New(TLSInsecureSkipVerify(true), HTTPClient(&http.Client{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := c.config().client.Transport.(*http.Transport)
+	if transport.MaxConnsPerHost != 100 || transport.MaxIdleConnsPerHost != 100 {
+		t.Errorf("default transport tuning is not applied: %d %d",
+			transport.MaxConnsPerHost, transport.MaxIdleConnsPerHost)
+	}
+}
+
+func TestTLSOptionsCustomRoundTripper(t *testing.T) {
+	rt := roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, nil })
+	_, err := New(TLSInsecureSkipVerify(true), HTTPClient(&http.Client{Transport: rt}))
+	if !errors.Is(err, ErrTLSConfig) {
+		t.Errorf("expected %v got %v", ErrTLSConfig, err)
+	}
+}
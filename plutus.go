@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+)
+
+type (
+	// PlutusDataKind is kind of PlutusData value.
+	PlutusDataKind int
+
+	// PlutusData is Plutus data value in detailed JSON schema
+	// as returned by datum and CIP-68 metadata endpoints.
+	PlutusData struct {
+		Kind PlutusDataKind
+		// Constructor index, set when Kind is PlutusDataConstr.
+		Constructor uint64
+		// Fields of constructor, set when Kind is PlutusDataConstr.
+		Fields []PlutusData
+		// Map key value pairs, set when Kind is PlutusDataMap.
+		Map []PlutusDataPair
+		// List items, set when Kind is PlutusDataList.
+		List []PlutusData
+		// Int value, set when Kind is PlutusDataInt.
+		Int *big.Int
+		// Bytes value decoded from hex, set when Kind is PlutusDataBytes.
+		Bytes []byte
+	}
+
+	// PlutusDataPair is key value pair of PlutusData map.
+	PlutusDataPair struct {
+		Key   PlutusData `json:"k"`
+		Value PlutusData `json:"v"`
+	}
+)
+
+// Plutus data kinds.
+const (
+	PlutusDataInvalid PlutusDataKind = iota
+	PlutusDataConstr
+	PlutusDataMap
+	PlutusDataList
+	PlutusDataInt
+	PlutusDataBytes
+)
+
+// UnmarshalJSON decodes Plutus data from detailed JSON schema.
+func (d *PlutusData) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Constructor *uint64           `json:"constructor"`
+		Fields      []PlutusData      `json:"fields"`
+		Map         *[]PlutusDataPair `json:"map"`
+		List        *[]PlutusData     `json:"list"`
+		Int         *json.Number      `json:"int"`
+		Bytes       *string           `json:"bytes"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*d = PlutusData{}
+	switch {
+	case raw.Constructor != nil:
+		d.Kind = PlutusDataConstr
+		d.Constructor = *raw.Constructor
+		d.Fields = raw.Fields
+	case raw.Map != nil:
+		d.Kind = PlutusDataMap
+		d.Map = *raw.Map
+	case raw.List != nil:
+		d.Kind = PlutusDataList
+		d.List = *raw.List
+	case raw.Int != nil:
+		n, ok := new(big.Int).SetString(raw.Int.String(), 10)
+		if !ok {
+			return fmt.Errorf("%w: invalid plutus data int %s", ErrUnexpectedResponseField, *raw.Int)
+		}
+		d.Kind = PlutusDataInt
+		d.Int = n
+	case raw.Bytes != nil:
+		v, err := hex.DecodeString(*raw.Bytes)
+		if err != nil {
+			return fmt.Errorf("%w: invalid plutus data bytes: %s", ErrUnexpectedResponseField, err.Error())
+		}
+		d.Kind = PlutusDataBytes
+		d.Bytes = v
+	default:
+		return fmt.Errorf("%w: unknown plutus data %s", ErrUnexpectedResponseField, string(b))
+	}
+	return nil
+}
+
+// MarshalJSON encodes Plutus data in detailed JSON schema.
+func (d PlutusData) MarshalJSON() ([]byte, error) {
+	switch d.Kind {
+	case PlutusDataConstr:
+		fields := d.Fields
+		if fields == nil {
+			fields = []PlutusData{}
+		}
+		return json.Marshal(struct {
+			Constructor uint64       `json:"constructor"`
+			Fields      []PlutusData `json:"fields"`
+		}{d.Constructor, fields})
+	case PlutusDataMap:
+		m := d.Map
+		if m == nil {
+			m = []PlutusDataPair{}
+		}
+		return json.Marshal(struct {
+			Map []PlutusDataPair `json:"map"`
+		}{m})
+	case PlutusDataList:
+		l := d.List
+		if l == nil {
+			l = []PlutusData{}
+		}
+		return json.Marshal(struct {
+			List []PlutusData `json:"list"`
+		}{l})
+	case PlutusDataInt:
+		n := d.Int
+		if n == nil {
+			n = new(big.Int)
+		}
+		return []byte(`{"int":` + n.String() + `}`), nil
+	case PlutusDataBytes:
+		return json.Marshal(struct {
+			Bytes string `json:"bytes"`
+		}{hex.EncodeToString(d.Bytes)})
+	}
+	return nil, fmt.Errorf("%w: invalid plutus data kind %d", ErrUnexpectedResponseField, d.Kind)
+}
+
+// Text returns bytes value as UTF-8 string. List of byte strings
+// is joined, since long strings are often split into 64 byte chunks.
+func (d PlutusData) Text() (string, bool) {
+	var b []byte
+	switch d.Kind {
+	case PlutusDataBytes:
+		b = d.Bytes
+	case PlutusDataList:
+		if len(d.List) == 0 {
+			return "", false
+		}
+		for _, item := range d.List {
+			if item.Kind != PlutusDataBytes {
+				return "", false
+			}
+			b = append(b, item.Bytes...)
+		}
+	default:
+		return "", false
+	}
+	if !utf8.Valid(b) {
+		return "", false
+	}
+	return string(b), true
+}
+
+// Hex returns bytes value as hex string.
+func (d PlutusData) Hex() string {
+	return hex.EncodeToString(d.Bytes)
+}
+
+// Int64 returns int value as int64.
+func (d PlutusData) Int64() (int64, bool) {
+	if d.Kind != PlutusDataInt || d.Int == nil || !d.Int.IsInt64() {
+		return 0, false
+	}
+	return d.Int.Int64(), true
+}
+
+// Get returns value of map entry which key is byte string equal to key.
+func (d PlutusData) Get(key string) (PlutusData, bool) {
+	for _, kv := range d.Map {
+		if kv.Key.Kind == PlutusDataBytes && bytes.Equal(kv.Key.Bytes, []byte(key)) {
+			return kv.Value, true
+		}
+	}
+	return PlutusData{}, false
+}
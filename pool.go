@@ -15,6 +15,8 @@ import (
 // introduces breaking change since v1.3.0
 
 type (
+	// PoolStatus of the pool (registered | retiring | retired).
+	PoolStatus string
 
 	// PoolListItem defines model for pool list item.
 	PoolListItem struct {
@@ -42,7 +44,7 @@ type (
 		// MetaHash Pool metadata hash
 		MetaHash string `json:"meta_hash"`
 		// Pool status (registered | retiring | retired).
-		PoolStatus string `json:"pool_status"`
+		PoolStatus PoolStatus `json:"pool_status"`
 		// Announced retiring epoch (nullable).
 		RetiringEpoch *EpochNo `json:"retiring_epoch.omitempty"`
 	}
@@ -85,7 +87,7 @@ type (
 		// MetaJson pool meta json
 		MetaJSON *PoolMetaJSON `json:"meta_json,omitempty"`
 
-		PoolStatus string `json:"pool_status"`
+		PoolStatus PoolStatus `json:"pool_status"`
 	}
 
 	// Relay defines model for pool relay.
@@ -135,7 +137,7 @@ type (
 		// MetaJson pool meta json
 		MetaJSON PoolMetaJSON `json:"meta_json"`
 		// Pool status (registered | retiring | retired)
-		PoolStatus string `json:"pool_status"`
+		PoolStatus PoolStatus `json:"pool_status"`
 		// Announced retiring epoch (nullable)
 		RetiringEpoch *EpochNo `json:"retiring_epoch"`
 		// OpCert Pool latest operational certificate hash
@@ -218,7 +220,7 @@ type (
 		Pledge decimal.Decimal `json:"pledge"`
 
 		// Pool status (registered | retiring | retired).
-		PoolStatus string `json:"pool_status"`
+		PoolStatus PoolStatus `json:"pool_status"`
 
 		// Announced retiring epoch (nullable).
 		RetiringEpoch *EpochNo `json:"retiring_epoch.omitempty"`
@@ -249,9 +251,9 @@ type (
 
 	// PoolRelays list item.
 	PoolRelays struct {
-		PoolIDBech32 PoolID  `json:"pool_id_bech32"`
-		Relays       []Relay `json:"relays"`
-		PoolStatus   string  `json:"pool_status"`
+		PoolIDBech32 PoolID     `json:"pool_id_bech32"`
+		Relays       []Relay    `json:"relays"`
+		PoolStatus   PoolStatus `json:"pool_status"`
 	}
 
 	// PoolBlockInfo block info.
@@ -301,96 +303,160 @@ type (
 		ActiveStake decimal.Decimal `json:"active_stake"`
 	}
 
-	PoolSnapshotResponse struct {
-		Response
-		Data []PoolSnapshot `json:"data"`
+	// PoolVotingPower entry of pool voting power history.
+	PoolVotingPower struct {
+		// PoolID Bech32 representation of pool ID.
+		PoolID PoolID `json:"pool_id_bech32"`
+		// EpochNo of the voting power snapshot.
+		EpochNo EpochNo `json:"epoch_no"`
+		// Amount of the voting power in lovelace.
+		Amount decimal.Decimal `json:"amount"`
+		// Percentage of the total pools voting power.
+		Percentage decimal.Decimal `json:"percentage"`
 	}
 
-	// PoolListResponse represents response from `/pool_list` endpoint.
-	PoolListResponse struct {
-		Response
-		Data []PoolListItem `json:"data"`
+	// PoolOwner entry of pool owner history.
+	PoolOwner struct {
+		// PoolID Bech32 representation of pool ID.
+		PoolID PoolID `json:"pool_id_bech32"`
+		// StakeAddress of the pool owner.
+		StakeAddress Address `json:"stake_address"`
+		// DeclaredPledge of the pool in lovelace.
+		DeclaredPledge decimal.Decimal `json:"declared_pledge"`
+		// PoolStatus (registered | retiring | retired).
+		PoolStatus PoolStatus `json:"pool_status"`
+		// RetiringEpoch announced retiring epoch (nullable).
+		RetiringEpoch *EpochNo `json:"retiring_epoch"`
 	}
 
-	// PoolInfosResponse represents response from `/pool_info` endpoint.
-	PoolInfosResponse struct {
-		Response
-		Data []PoolInfo `json:"data"`
+	// PoolCalidusKey registered calidus key of the pool.
+	PoolCalidusKey struct {
+		// PoolID Bech32 representation of pool ID.
+		PoolID PoolID `json:"pool_id_bech32"`
+		// PoolStatus (registered | retiring | retired).
+		PoolStatus PoolStatus `json:"pool_status"`
+		// CalidusNonce of the registration.
+		CalidusNonce uint64 `json:"calidus_nonce"`
+		// CalidusPubKey hex encoded calidus public key.
+		CalidusPubKey string `json:"calidus_pub_key"`
+		// CalidusID Bech32 representation of calidus key ID.
+		CalidusID string `json:"calidus_id_bech32"`
+		// TxHash of the transaction which registered the key.
+		TxHash TxHash `json:"tx_hash"`
+		// EpochNo of the registration.
+		EpochNo EpochNo `json:"epoch_no"`
+		// BlockHeight of the registration.
+		BlockHeight uint64 `json:"block_height"`
+		// BlockTime of the registration.
+		BlockTime Timestamp `json:"block_time"`
+	}
+
+	// PoolGroup pool grouping by operator as reported by pool explorers.
+	PoolGroup struct {
+		// PoolID Bech32 representation of pool ID.
+		PoolID PoolID `json:"pool_id_bech32"`
+		// PoolGroup name of the group pool belongs to.
+		PoolGroup string `json:"pool_group"`
+		// Ticker of the pool.
+		Ticker string `json:"ticker"`
+		// AdastatGroup group according to adastat.net (nullable).
+		AdastatGroup string `json:"adastat_group"`
+		// BalanceanalyticsGroup group according to balanceanalytics.io (nullable).
+		BalanceanalyticsGroup string `json:"balanceanalytics_group"`
 	}
 
+	PoolSnapshotResponse = ListResponse[PoolSnapshot]
+
+	// PoolOwnerHistoryResponse represents response from `/pool_owner_history` endpoint.
+	PoolOwnerHistoryResponse = ListResponse[PoolOwner]
+
+	// PoolCalidusKeysResponse represents response from `/pool_calidus_keys` endpoint.
+	PoolCalidusKeysResponse = ListResponse[PoolCalidusKey]
+
+	// PoolGroupsResponse represents response from `/pool_groups` endpoint.
+	PoolGroupsResponse = ListResponse[PoolGroup]
+
+	// PoolVotingPowerHistoryResponse represents response from `/pool_voting_power_history` endpoint.
+	PoolVotingPowerHistoryResponse = ListResponse[PoolVotingPower]
+
+	// PoolVotesResponse represents response from `/pool_votes` endpoint.
+	PoolVotesResponse = ListResponse[GovernanceVote]
+
+	// PoolListResponse represents response from `/pool_list` endpoint.
+	PoolListResponse = ListResponse[PoolListItem]
+
+	// PoolInfosResponse represents response from `/pool_info` endpoint.
+	PoolInfosResponse = ListResponse[PoolInfo]
+
 	// PoolInfoResponse represents response from `/pool_info` endpoint.
 	// when requesting info about single pool.
-	PoolInfoResponse struct {
-		Response
-		Data *PoolInfo `json:"data"`
-	}
+	PoolInfoResponse = Response[*PoolInfo]
 
 	// PoolDelegatorsResponse represents response from `/pool_delegators` endpoint.
-	PoolDelegatorsResponse struct {
-		Response
-		Data []PoolDelegator `json:"data"`
-	}
+	PoolDelegatorsResponse = ListResponse[PoolDelegator]
 
-	PoolDelegatorsHistoryResponse struct {
-		Response
-		Data []PoolDelegatorHistory `json:"data"`
-	}
+	PoolDelegatorsHistoryResponse = ListResponse[PoolDelegatorHistory]
 
 	// PoolBlocksResponse represents response from `/pool_blocks` endpoint.
-	PoolBlocksResponse struct {
-		Response
-		Data []PoolBlockInfo `json:"data"`
-	}
+	PoolBlocksResponse = ListResponse[PoolBlockInfo]
 
 	// PoolUpdatesResponse represents response from `/pool_updates` endpoint.
-	PoolUpdatesResponse struct {
-		Response
-		Data []PoolUpdateInfo `json:"data"`
-	}
+	PoolUpdatesResponse = ListResponse[PoolUpdateInfo]
 
 	// PoolRelaysResponse represents response from `/pool_relays` endpoint.
-	PoolRelaysResponse struct {
-		Response
-		Data []PoolRelays `json:"data"`
-	}
+	PoolRelaysResponse = ListResponse[PoolRelays]
 
 	// PoolMetadataResponse represents response from `/pool_metadata` endpoint.
-	PoolMetadataResponse struct {
-		Response
-		Data []PoolMetadata `json:"data"`
-	}
+	PoolMetadataResponse = ListResponse[PoolMetadata]
 
 	// PoolHistoryResponse represents response from `/pool_history` endpoint.
-	PoolHistoryResponse struct {
-		Response
-		Data []PoolHistory `json:"data"`
-	}
+	PoolHistoryResponse = ListResponse[PoolHistory]
 
-	PoolRegistrationsResponse struct {
-		Response
-		Data []PoolRegistrationOrRetirement `json:"data"`
-	}
+	PoolRegistrationsResponse = ListResponse[PoolRegistrationOrRetirement]
 
-	PoolRetirementsResponse struct {
-		Response
-		Data []PoolRegistrationOrRetirement `json:"data"`
-	}
+	PoolRetirementsResponse = ListResponse[PoolRegistrationOrRetirement]
+)
+
+// Statuses of the pools.
+const (
+	PoolStatusRegistered PoolStatus = "registered"
+	PoolStatusRetiring   PoolStatus = "retiring"
+	PoolStatusRetired    PoolStatus = "retired"
 )
 
+// String returns PoolStatus as string.
+func (v PoolStatus) String() string {
+	return string(v)
+}
+
 // GetPoolList returns the list of all currently registered/retiring (not retired) pools.
 func (c *Client) GetPoolList(
 	ctx context.Context,
 	opts *RequestOptions,
 ) (res *PoolListResponse, err error) {
 	res = &PoolListResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_list", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
+// GetPoolListByStatus returns the list of pools with given status
+// e.g. PoolStatusRetiring lists pools which announced retirement.
+func (c *Client) GetPoolListByStatus(
+	ctx context.Context,
+	status PoolStatus,
+	opts *RequestOptions,
+) (res *PoolListResponse, err error) {
+	opts = c.requestOptions(opts)
+	if len(status) > 0 {
+		opts.QuerySet("pool_status", "eq."+status.String())
+	}
+	return c.GetPoolList(ctx, opts)
+}
+
 // GetPoolInfo returns current pool status and details for a specified pool.
 func (c *Client) GetPoolInfo(
 	ctx context.Context,
@@ -399,10 +465,11 @@ func (c *Client) GetPoolInfo(
 ) (res *PoolInfoResponse, err error) {
 	res = &PoolInfoResponse{}
 	rsp, err := c.GetPoolInfos(ctx, []PoolID{pid}, opts)
-	res.Response = rsp.Response
-	if len(rsp.Data) == 1 {
-		res.Data = &rsp.Data[0]
+	res.ResponseMeta = rsp.ResponseMeta
+	if err != nil {
+		return
 	}
+	res.Data, err = firstItem(rsp.Data, "pool %s", pid)
 	return
 }
 
@@ -419,11 +486,23 @@ func (c *Client) GetPoolInfos(
 		return
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/pool_info", poolIdsPL(pids), opts)
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_pool_bech32_ids", pids)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pids, err = bech32PoolIDs(pids); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/pool_info", poolIdsPL(pids), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -433,16 +512,26 @@ func (c *Client) GetPoolStakeSnapshot(
 	opts *RequestOptions,
 ) (res *PoolSnapshotResponse, err error) {
 	res = &PoolSnapshotResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_pool_bech32", pid.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_stake_snapshot", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_stake_snapshot", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -455,16 +544,26 @@ func (c *Client) GetPoolDelegators(
 ) (res *PoolDelegatorsResponse, err error) {
 	res = &PoolDelegatorsResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_pool_bech32", pid.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_delegators", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_delegators", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	return
 }
@@ -476,19 +575,30 @@ func (c *Client) GetPoolDelegatorsHistory(
 ) (res *PoolDelegatorsHistoryResponse, err error) {
 	res = &PoolDelegatorsHistoryResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_pool_bech32", pid.String())
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_delegators_history", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_delegators_history", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 
 	return
 }
@@ -503,18 +613,29 @@ func (c *Client) GetPoolBlocks(
 ) (res *PoolBlocksResponse, err error) {
 	res = &PoolBlocksResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_pool_bech32", pid.String())
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_blocks", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_blocks", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -527,18 +648,32 @@ func (c *Client) GetPoolUpdates(
 ) (res *PoolUpdatesResponse, err error) {
 	res = &PoolUpdatesResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		if len(pid) > 0 {
+			v.value("_pool_bech32", pid)
+		}
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if len(pid) > 0 {
+		if pid, err = pid.Bech32(); err != nil {
+			res.applyError(nil, err)
+			return
+		}
 	}
-	if pid.Valid() {
+
+	opts = c.requestOptions(opts)
+	if len(pid) > 0 {
 		opts.QuerySet("_pool_bech32", pid.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_updates", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_updates", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -550,11 +685,11 @@ func (c *Client) GetPoolRelays(
 ) (res *PoolRelaysResponse, err error) {
 	res = &PoolRelaysResponse{}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_relays", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_relays", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -567,11 +702,25 @@ func (c *Client) GetPoolMetadata(
 ) (res *PoolMetadataResponse, err error) {
 	res = &PoolMetadataResponse{}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/pool_metadata", poolIdsPL(pids), opts)
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_pool_bech32_ids", pids)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if len(pids) > 0 {
+		if pids, err = bech32PoolIDs(pids); err != nil {
+			res.applyError(nil, err)
+			return
+		}
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/pool_metadata", poolIdsPL(pids), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -586,18 +735,169 @@ func (c *Client) GetPoolHistory(
 ) (res *PoolHistoryResponse, err error) {
 	res = &PoolHistoryResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_pool_bech32", pid.String())
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_history", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_history", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolVotingPowerHistory returns history of voting power of given pool
+// or all pools when pid is empty, within provided epoch range.
+func (c *Client) GetPoolVotingPowerHistory(
+	ctx context.Context,
+	pid PoolID,
+	epochs EpochRange,
+	opts *RequestOptions,
+) (res *PoolVotingPowerHistoryResponse, err error) {
+	res = &PoolVotingPowerHistoryResponse{}
+
+	if err = c.validate(func(v *validator) {
+		if len(pid) > 0 {
+			v.value("_pool_bech32", pid)
+		}
+		v.epochs("epoch_no", epochs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if len(pid) > 0 {
+		if pid, err = pid.Bech32(); err != nil {
+			res.applyError(nil, err)
+			return
+		}
+	}
+
+	opts = c.requestOptions(opts)
+	if len(pid) > 0 {
+		opts.QuerySet("_pool_bech32", pid.String())
+	}
+	epochs.applyQuery(opts)
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_voting_power_history", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolVotes returns list of all votes cast by given pool.
+func (c *Client) GetPoolVotes(
+	ctx context.Context,
+	pid PoolID,
+	opts *RequestOptions,
+) (res *PoolVotesResponse, err error) {
+	res = &PoolVotesResponse{}
+	if len(pid) == 0 {
+		err = ErrNoPoolID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		v.value("_pool_bech32", pid)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pid, err = pid.Bech32(); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+	opts = c.requestOptions(opts)
+	opts.QuerySet("_pool_bech32", pid.String())
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_votes", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolOwnerHistory returns history of owners and declared pledge
+// for given pools.
+func (c *Client) GetPoolOwnerHistory(
+	ctx context.Context,
+	pids []PoolID,
+	opts *RequestOptions,
+) (res *PoolOwnerHistoryResponse, err error) {
+	res = &PoolOwnerHistoryResponse{}
+	if len(pids) == 0 {
+		err = ErrNoPoolID
+		res.applyError(nil, err)
+		return
+	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_pool_bech32_ids", pids)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	if pids, err = bech32PoolIDs(pids); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/pool_owner_history", poolIdsPL(pids), opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolCalidusKeys returns the list of calidus keys registered by pools.
+func (c *Client) GetPoolCalidusKeys(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *PoolCalidusKeysResponse, err error) {
+	res = &PoolCalidusKeysResponse{}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_calidus_keys", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+	return
+}
+
+// GetPoolGroups returns the list of pools and operator groups they belong to.
+func (c *Client) GetPoolGroups(
+	ctx context.Context,
+	opts *RequestOptions,
+) (res *PoolGroupsResponse, err error) {
+	res = &PoolGroupsResponse{}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_groups", nil, opts)
+	if err != nil {
+		return
+	}
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -619,19 +919,25 @@ func (c *Client) GetPoolRegistrations(
 	opts *RequestOptions,
 ) (res *PoolRegistrationsResponse, err error) {
 	res = &PoolRegistrationsResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
 
+	opts = c.requestOptions(opts)
+
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_registrations", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_registrations", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -641,18 +947,24 @@ func (c *Client) GetPoolRetirements(
 	opts *RequestOptions,
 ) (res *PoolRetirementsResponse, err error) {
 	res = &PoolRetirementsResponse{}
-	if opts == nil {
-		opts = c.NewRequestOptions()
+
+	if err = c.validate(func(v *validator) {
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
 
+	opts = c.requestOptions(opts)
+
 	if epoch > 0 {
 		opts.QuerySet("_epoch_no", epoch.String())
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/pool_retirements", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/pool_retirements", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPoolIDEncoding(t *testing.T) {
+	const (
+		bech32 PoolID = "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+		hexID  PoolID = "0f292fcaa02b8b2f9b3c8f9fd8e0bb21abedb692a6d5058df3ef2735"
+	)
+	for _, pid := range []PoolID{bech32, hexID} {
+		if !pid.Valid() {
+			t.Errorf("%s: expected valid pool id", pid)
+		}
+		if got, err := pid.Bech32(); err != nil || got != bech32 {
+			t.Errorf("%s: expected %s got %s (%v)", pid, bech32, got, err)
+		}
+		if got, err := pid.Hex(); err != nil || got != hexID {
+			t.Errorf("%s: expected %s got %s (%v)", pid, hexID, got, err)
+		}
+	}
+}
+
+func TestPoolIDError(t *testing.T) {
+	tests := []PoolID{
+		"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdz",
+		"0f292fcaa02b8b2f9b3c8f9fd8e0bb21abedb692a6d5058df3ef27",
+		"0f292fcaa02b8b2f9b3c8f9fd8e0bb21abedb692a6d5058df3ef27zz",
+		"stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+	}
+	for _, pid := range tests {
+		t.Run(pid.String(), func(t *testing.T) {
+			_, err := pid.Bech32()
+			if !errors.Is(err, ErrInvalidPoolID) {
+				t.Fatalf("expected %v got %v", ErrInvalidPoolID, err)
+			}
+			var perr *PoolIDError
+			if !errors.As(err, &perr) {
+				t.Fatalf("expected *PoolIDError got %T", err)
+			}
+			if perr.PoolID != pid || perr.Err == nil {
+				t.Errorf("expected pool id %s with cause got %s %v", pid, perr.PoolID, perr.Err)
+			}
+		})
+	}
+	if _, err := PoolID("").Bech32(); !errors.Is(err, ErrNoPoolID) {
+		t.Errorf("expected %v got %v", ErrNoPoolID, err)
+	}
+}
@@ -68,13 +68,15 @@ func (ro *RequestOptions) Clone() *RequestOptions {
 		headers:       ro.headers.Clone(),
 		page:          ro.page,
 		pageSize:      ro.pageSize,
-		query:         ro.query,
 		requestsToday: ro.requestsToday,
 		locked:        false,
 	}
-	q := url.Values{}
+	if opts.headers == nil {
+		opts.headers = http.Header{}
+	}
+	opts.query = make(url.Values, len(ro.query))
 	for k, v := range ro.query {
-		q[k] = v
+		opts.query[k] = append([]string(nil), v...)
 	}
 	return opts
 }
@@ -94,6 +96,24 @@ func (ro *RequestOptions) SetRequestsToday(n uint) {
 	ro.requestsToday = n
 }
 
+// eachPage calls fetch with options of consecutive pages starting
+// from the page set in provided options, until fetch returns
+// less items than the page size.
+func (c *Client) eachPage(opts *RequestOptions, fetch func(opts *RequestOptions) (int, error)) error {
+	base := c.requestOptions(opts)
+	for page := base.page; ; page++ {
+		popts := base.Clone()
+		popts.SetCurrentPage(page)
+		n, err := fetch(popts)
+		if err != nil {
+			return err
+		}
+		if n == 0 || n < int(base.pageSize) {
+			return nil
+		}
+	}
+}
+
 // lock the request options.
 func (ro *RequestOptions) lock() error {
 	if ro.locked {
@@ -107,3 +127,94 @@ func (ro *RequestOptions) lock() error {
 	}
 	return nil
 }
+
+// RequestTemplate is immutable set of request options which can be reused
+// across requests and safely shared between goroutines.
+// Every modifier returns new RequestTemplate and leaves the receiver unchanged.
+// e.g.
+//
+//	base := koios.NewRequestTemplate().HeaderSet("X-Trace", "1").PageSize(100)
+//	res, err := api.GetPoolList(ctx, base.Page(2).Options())
+type RequestTemplate struct {
+	opts *RequestOptions
+}
+
+// NewRequestTemplate returns new request template with default options.
+func NewRequestTemplate() RequestTemplate {
+	return RequestTemplate{
+		opts: &RequestOptions{
+			page:     1,
+			pageSize: PageSize,
+			query:    url.Values{},
+			headers:  http.Header{},
+		},
+	}
+}
+
+// Options returns new RequestOptions derived from the template
+// which can be passed to any request.
+func (t RequestTemplate) Options() *RequestOptions {
+	return t.options().Clone()
+}
+
+// QuerySet returns new template with the key set to value in request query.
+// It replaces any existing values.
+func (t RequestTemplate) QuerySet(key, val string) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.QuerySet(key, val) })
+}
+
+// QueryAdd returns new template with the value added to request query by key.
+// It appends to any existing values associated with key.
+func (t RequestTemplate) QueryAdd(key, val string) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.QueryAdd(key, val) })
+}
+
+// QueryApply returns new template with all values set from provided query.
+func (t RequestTemplate) QueryApply(q url.Values) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.QueryApply(q) })
+}
+
+// HeaderSet returns new template with the key set to value in request headers.
+// It replaces any existing values.
+func (t RequestTemplate) HeaderSet(key, val string) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.HeaderSet(key, val) })
+}
+
+// HeaderAdd returns new template with the value added to request headers by key.
+// It appends to any existing values associated with key.
+func (t RequestTemplate) HeaderAdd(key, val string) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.HeaderAdd(key, val) })
+}
+
+// HeaderApply returns new template with all values set from provided header.
+func (t RequestTemplate) HeaderApply(h http.Header) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.HeaderApply(h) })
+}
+
+// PageSize returns new template with modified page size.
+func (t RequestTemplate) PageSize(size uint) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.SetPageSize(size) })
+}
+
+// Page returns new template with modified current page.
+func (t RequestTemplate) Page(page uint) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.SetCurrentPage(page) })
+}
+
+// RequestsToday returns new template with modified number of requests made today.
+func (t RequestTemplate) RequestsToday(n uint) RequestTemplate {
+	return t.with(func(ro *RequestOptions) { ro.SetRequestsToday(n) })
+}
+
+func (t RequestTemplate) options() *RequestOptions {
+	if t.opts == nil {
+		return NewRequestTemplate().opts
+	}
+	return t.opts
+}
+
+func (t RequestTemplate) with(modify func(ro *RequestOptions)) RequestTemplate {
+	opts := t.options().Clone()
+	modify(opts)
+	return RequestTemplate{opts: opts}
+}
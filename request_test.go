@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func TestRequestTemplateImmutable(t *testing.T) {
+	base := NewRequestTemplate().
+		QuerySet("select", "a").
+		HeaderSet("X-Trace", "1").
+		PageSize(100)
+
+	derived := []RequestTemplate{
+		base.QuerySet("select", "b"),
+		base.QueryAdd("select", "b"),
+		base.QueryApply(url.Values{"select": {"b"}, "order": {"asc"}}),
+		base.HeaderSet("X-Trace", "2"),
+		base.HeaderAdd("X-Trace", "2"),
+		base.HeaderApply(http.Header{"X-Trace": {"2"}, "X-Other": {"1"}}),
+		base.PageSize(10),
+		base.Page(2),
+		base.RequestsToday(5),
+	}
+	for _, d := range derived {
+		if d.opts == base.opts {
+			t.Fatal("modifier returned template sharing options with parent")
+		}
+	}
+	assertBase := func(t *testing.T) {
+		t.Helper()
+		opts := base.Options()
+		if got := opts.query["select"]; len(got) != 1 || got[0] != "a" || len(opts.query) != 1 {
+			t.Errorf("parent query modified: %v", opts.query)
+		}
+		if got := opts.headers.Values("X-Trace"); len(got) != 1 || got[0] != "1" || len(opts.headers) != 1 {
+			t.Errorf("parent headers modified: %v", opts.headers)
+		}
+		if opts.pageSize != 100 || opts.page != 1 || opts.requestsToday != 0 {
+			t.Errorf("parent paging modified: size %d page %d today %d", opts.pageSize, opts.page, opts.requestsToday)
+		}
+	}
+	assertBase(t)
+
+	// options derived from template are deep copies.
+	opts := base.Options()
+	opts.QuerySet("select", "c")
+	opts.QueryAdd("limit", "1")
+	opts.query["select"][0] = "d"
+	opts.HeaderAdd("X-Trace", "3")
+	opts.headers["X-Trace"][0] = "4"
+	opts.SetCurrentPage(3)
+	if err := opts.lock(); err != nil {
+		t.Fatal(err)
+	}
+	assertBase(t)
+	if other := base.Options(); other.locked {
+		t.Error("options derived from template are locked")
+	}
+
+	// derived templates are deep copies as well.
+	child := base.QueryAdd("order", "asc")
+	child.opts.query["select"][0] = "e"
+	child.opts.headers["X-Trace"][0] = "5"
+	assertBase(t)
+
+	// zero value template behaves as default one.
+	var zero RequestTemplate
+	if opts := zero.PageSize(10).Options(); opts.pageSize != 10 || opts.page != 1 {
+		t.Errorf("unexpected options of zero template: size %d page %d", opts.pageSize, opts.page)
+	}
+	if zero.opts != nil {
+		t.Error("zero template modified")
+	}
+
+	// shared template used concurrently, meant to be run with -race.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(page uint) {
+			defer wg.Done()
+			opts := base.Page(page).HeaderSet("X-Page", "1").Options()
+			opts.QuerySet("select", "x")
+			if opts.page != page {
+				t.Errorf("expected page %d got %d", page, opts.page)
+			}
+		}(uint(i + 1))
+	}
+	wg.Wait()
+	assertBase(t)
+}
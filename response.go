@@ -11,14 +11,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 )
 
 type (
-	// Response wraps API responses.
-	Response struct {
+	// ResponseMeta holds API response metadata
+	// shared by all typed responses.
+	ResponseMeta struct {
 		// RequestURL is full request url.
 		RequestURL string `json:"request_url"`
 
@@ -47,6 +49,20 @@ type (
 		Stats *RequestStats `json:"stats,omitempty"`
 	}
 
+	// Response is generic typed API response.
+	// Endpoints returning single item use pointer type e.g. Response[*Tip]
+	// where Data is nil when API did not return the item.
+	Response[T any] struct {
+		ResponseMeta
+		Data T `json:"data"`
+	}
+
+	// ListResponse is generic typed API response for endpoints returning list of items.
+	ListResponse[T any] struct {
+		ResponseMeta
+		Data []T `json:"data"`
+	}
+
 	ErrorCode string
 
 	// ResponseError represents api error messages.
@@ -88,7 +104,7 @@ func (e *ResponseError) Error() string {
 	return e.Message
 }
 
-// Error return underlying error string.
+// Unwrap returns underlying error.
 func (e *ResponseError) Unwrap() error {
 	return e.error
 }
@@ -118,7 +134,7 @@ func ReadResponseBody(rsp *http.Response) (body []byte, err error) {
 }
 
 // ReadAndUnmarshalResponse is helper to unmarchal json responses.
-func ReadAndUnmarshalResponse(rsp *http.Response, res *Response, dest any) error {
+func ReadAndUnmarshalResponse(rsp *http.Response, res *ResponseMeta, dest any) error {
 	if rsp == nil {
 		return fmt.Errorf("%w: got no response", ErrResponse)
 	}
@@ -138,7 +154,7 @@ func ReadAndUnmarshalResponse(rsp *http.Response, res *Response, dest any) error
 	return err
 }
 
-func (r *Response) applyError(body []byte, err error) {
+func (r *ResponseMeta) applyError(body []byte, err error) {
 	if err == nil {
 		return
 	}
@@ -163,7 +179,7 @@ func (r *Response) applyError(body []byte, err error) {
 	}
 }
 
-func (r *Response) ready() {
+func (r *ResponseMeta) ready() {
 	if r.Stats == nil {
 		return
 	}
@@ -174,7 +190,7 @@ func (r *Response) ready() {
 	}
 }
 
-func (r *Response) applyRsp(rsp *http.Response) {
+func (r *ResponseMeta) applyRsp(rsp *http.Response) {
 	r.StatusCode = rsp.StatusCode
 	r.RequestMethod = rsp.Request.Method
 	r.Status = rsp.Status
@@ -182,3 +198,62 @@ func (r *Response) applyRsp(rsp *http.Response) {
 	r.ContentRange = rsp.Header.Get("content-range")
 	r.ContentLocation = rsp.Header.Get("content-location")
 }
+
+// First returns response data or ErrNoData when response has no data.
+func (r *Response[T]) First() (data T, err error) {
+	if r.Empty() {
+		return data, fmt.Errorf("%w: %s", ErrNoData, r.RequestURL)
+	}
+	return r.Data, nil
+}
+
+// Len returns number of items in response. For slice and map data types
+// it returns its length, for other types it returns 1 unless data is empty.
+func (r *Response[T]) Len() int {
+	v := reflect.ValueOf(&r.Data).Elem()
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len()
+	}
+	if v.IsZero() {
+		return 0
+	}
+	return 1
+}
+
+// Empty reports whether response data is nil or zero value.
+// Slices, maps and arrays are empty when they have no elements.
+func (r *Response[T]) Empty() bool {
+	v := reflect.ValueOf(&r.Data).Elem()
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// First returns first item of the response or ErrNoData when list is empty.
+func (r *ListResponse[T]) First() (item T, err error) {
+	if len(r.Data) == 0 {
+		return item, fmt.Errorf("%w: %s", ErrNoData, r.RequestURL)
+	}
+	return r.Data[0], nil
+}
+
+// Len returns number of items in response.
+func (r *ListResponse[T]) Len() int {
+	return len(r.Data)
+}
+
+// Empty reports whether response has no items.
+func (r *ListResponse[T]) Empty() bool {
+	return len(r.Data) == 0
+}
+
+// firstItem returns pointer to first item or wrapped ErrNoData when list is empty.
+func firstItem[T any](items []T, format string, args ...any) (*T, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: "+format, append([]any{ErrNoData}, args...)...)
+	}
+	return &items[0], nil
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import "testing"
+
+func TestResponseEmpty(t *testing.T) {
+	tests := []struct {
+		name  string
+		empty bool
+		len   int
+		res   interface {
+			Empty() bool
+			Len() int
+		}
+	}{
+		{"nil slice", true, 0, &Response[[]int]{}},
+		{"empty slice", true, 0, &Response[[]int]{Data: []int{}}},
+		{"slice", false, 2, &Response[[]int]{Data: []int{0, 0}}},
+		{"empty map", true, 0, &Response[map[string]int]{Data: map[string]int{}}},
+		{"zero array", false, 2, &Response[[2]int]{}},
+		{"empty array", true, 0, &Response[[0]int]{}},
+		{"zero struct", true, 0, &Response[Tip]{}},
+		{"struct", false, 1, &Response[Tip]{Data: Tip{EpochNo: 1}}},
+		{"nil pointer", true, 0, &Response[*Tip]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.Empty(); got != tt.empty {
+				t.Errorf("Empty() = %t, want %t", got, tt.empty)
+			}
+			if got := tt.res.Len(); got != tt.len {
+				t.Errorf("Len() = %d, want %d", got, tt.len)
+			}
+		})
+	}
+}
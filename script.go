@@ -92,10 +92,7 @@ type (
 	}
 
 	// NativeScriptListResponse represents response from `/native_script_list` endpoint.
-	NativeScriptListResponse struct {
-		Response
-		Data []NativeScript `json:"data"`
-	}
+	NativeScriptListResponse = ListResponse[NativeScript]
 
 	// PlutusScriptListItem item of plutus script list.
 	PlutusScriptListItem struct {
@@ -113,36 +110,18 @@ type (
 	}
 
 	// PlutusScriptListResponse represents response from `/plutus_script_list` endpoint.
-	PlutusScriptListResponse struct {
-		Response
-		Data []PlutusScriptListItem `json:"data"`
-	}
+	PlutusScriptListResponse = ListResponse[PlutusScriptListItem]
 
 	// ScriptRedeemersResponse represents response from `/script_redeemers` endpoint.
-	ScriptRedeemersResponse struct {
-		Response
-		Data *ScriptRedeemers `json:"data"`
-	}
+	ScriptRedeemersResponse = Response[*ScriptRedeemers]
 
 	// DatumInfosResponse represents response from `/datum_info` endpoint.
-	DatumInfosResponse struct {
-		Response
-		Data []DatumInfo `json:"data"`
-	}
-	DatumInfoResponse struct {
-		Response
-		Data *DatumInfo `json:"data"`
-	}
+	DatumInfosResponse = ListResponse[DatumInfo]
+	DatumInfoResponse  = Response[*DatumInfo]
 
-	ScriptInfosResponse struct {
-		Response
-		Data []ScriptInfo `json:"data"`
-	}
+	ScriptInfosResponse = ListResponse[ScriptInfo]
 
-	ScriptUTxOsResponse struct {
-		Response
-		Data []UTxO `json:"data"`
-	}
+	ScriptUTxOsResponse = ListResponse[UTxO]
 )
 
 // String returns ScriptHash as string.
@@ -169,11 +148,11 @@ func (c *Client) GetNativeScripts(
 	opts *RequestOptions,
 ) (res *NativeScriptListResponse, err error) {
 	res = &NativeScriptListResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/native_script_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/native_script_list", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -184,11 +163,11 @@ func (c *Client) GetPlutusScripts(
 	opts *RequestOptions,
 ) (res *PlutusScriptListResponse, err error) {
 	res = &PlutusScriptListResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/plutus_script_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/plutus_script_list", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -200,21 +179,25 @@ func (c *Client) GetScriptRedeemers(
 ) (res *ScriptRedeemersResponse, err error) {
 	res = &ScriptRedeemersResponse{}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err = c.validate(func(v *validator) {
+		v.value("_script_hash", sh)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
 	}
+
+	opts = c.requestOptions(opts)
 	opts.QuerySet("_script_hash", sh.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/script_redeemers", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/script_redeemers", nil, opts)
 	if err != nil {
 		return
 	}
 	r := []ScriptRedeemers{}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &r)
-
-	if len(r) == 1 {
-		res.Data = &r[0]
+	if err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &r); err != nil {
+		return
 	}
+	res.Data, err = firstItem(r, "script redeemers %s", sh)
 	return
 }
 
@@ -226,12 +209,11 @@ func (c *Client) GetDatumInfo(
 ) (res *DatumInfoResponse, err error) {
 	res = &DatumInfoResponse{}
 	rsp, err := c.GetDatumInfos(ctx, []DatumHash{hash}, opts)
-	res.Response = rsp.Response
-	if len(rsp.Data) == 1 {
-		res.Data = &rsp.Data[0]
-	} else {
-		err = fmt.Errorf("%w: %s", ErrNoData, hash)
+	res.ResponseMeta = rsp.ResponseMeta
+	if err != nil {
+		return
 	}
+	res.Data, err = firstItem(rsp.Data, "datum %s", hash)
 	return
 }
 
@@ -248,11 +230,18 @@ func (c *Client) GetDatumInfos(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/datum_info", datumHashesPL(hashes), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_datum_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/datum_info", datumHashesPL(hashes), opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func (c *Client) GetScriptInfo(
@@ -267,11 +256,18 @@ func (c *Client) GetScriptInfo(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/script_info", scriptHashesPL(hashes), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_script_hashes", hashes)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/script_info", scriptHashesPL(hashes), opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func (c *Client) GetScriptUtxos(
@@ -287,18 +283,23 @@ func (c *Client) GetScriptUtxos(
 		return res, err
 	}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err := c.validate(func(v *validator) {
+		v.value("_script_hash", hash)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
 	}
 
+	opts = c.requestOptions(opts)
+
 	opts.QuerySet("_extended", fmt.Sprintf("%t", Extended))
 	opts.QuerySet("_script_hash", hash.String())
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/script_utxos", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/script_utxos", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func datumHashesPL(hashes []DatumHash) io.Reader {
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// Era is part of chain history with constant slot and epoch length.
+	Era struct {
+		Name string `json:"name"`
+		// StartEpoch is first epoch of the era.
+		StartEpoch EpochNo `json:"start_epoch"`
+		// StartSlot is first absolute slot of the era.
+		StartSlot Slot `json:"start_slot"`
+		// SlotLength is duration of single slot.
+		SlotLength time.Duration `json:"slot_length"`
+		// EpochLength is number of slots in epoch.
+		EpochLength uint64 `json:"epoch_length"`
+	}
+
+	// TimeConverter converts between slots, epochs and time.
+	// It does not make any requests once created.
+	TimeConverter struct {
+		systemStart time.Time
+		eras        []Era
+		// starts are start times of eras.
+		starts []time.Time
+	}
+)
+
+// Byron era slot and epoch length, same for all networks started in Byron era.
+const (
+	byronSlotLength  = 20 * time.Second
+	byronEpochLength = 21600
+)
+
+// TimeConverter returns TimeConverter for network of the client.
+func (c *Client) TimeConverter(ctx context.Context, opts *RequestOptions) (*TimeConverter, error) {
+	res, err := c.GetGenesis(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewTimeConverter(res.Data)
+}
+
+// NewTimeConverter returns TimeConverter for network described by genesis.
+// Byron era boundaries are taken from predefined network profiles,
+// other networks are expected to start in Shelley era.
+func NewTimeConverter(g *Genesis) (*TimeConverter, error) {
+	if g == nil {
+		return nil, fmt.Errorf("%w: genesis is required", ErrTimeConverter)
+	}
+	shelley := Era{
+		Name:        "shelley",
+		SlotLength:  time.Duration(g.SlotLength.Mul(decimal.NewFromInt(int64(time.Second))).IntPart()),
+		EpochLength: uint64(g.EpochLength.IntPart()),
+	}
+	var eras []Era
+	if n, ok := NetworkByMagic(uint32(g.NetworkMagic.IntPart())); ok && n.ShelleyStartEpoch > 0 {
+		eras = append(eras, Era{Name: "byron", SlotLength: byronSlotLength, EpochLength: byronEpochLength})
+		shelley.StartEpoch = n.ShelleyStartEpoch
+		shelley.StartSlot = Slot(uint64(n.ShelleyStartEpoch) * byronEpochLength)
+	}
+	eras = append(eras, shelley)
+	return NewTimeConverterFromEras(g.SystemStart.Time, eras...)
+}
+
+// NewTimeConverterFromEras returns TimeConverter for given system start and era history.
+// Eras must be ordered and first era must start at slot 0.
+func NewTimeConverterFromEras(systemStart time.Time, eras ...Era) (*TimeConverter, error) {
+	if len(eras) == 0 || eras[0].StartSlot != 0 || eras[0].StartEpoch != 0 {
+		return nil, fmt.Errorf("%w: first era must start at slot 0", ErrTimeConverter)
+	}
+	tc := &TimeConverter{
+		systemStart: systemStart,
+		eras:        append([]Era(nil), eras...),
+		starts:      make([]time.Time, len(eras)),
+	}
+	start := systemStart
+	for i, era := range eras {
+		if era.SlotLength <= 0 || era.EpochLength == 0 {
+			return nil, fmt.Errorf("%w: invalid slot or epoch length of era %s", ErrTimeConverter, era.Name)
+		}
+		if i > 0 {
+			prev := eras[i-1]
+			epochs := uint64(era.StartEpoch - prev.StartEpoch)
+			if era.StartEpoch <= prev.StartEpoch || uint64(era.StartSlot-prev.StartSlot) != epochs*prev.EpochLength {
+				return nil, fmt.Errorf("%w: era %s does not start at epoch boundary", ErrTimeConverter, era.Name)
+			}
+			start = start.Add(time.Duration(epochs*prev.EpochLength) * prev.SlotLength)
+		}
+		tc.starts[i] = start
+	}
+	return tc, nil
+}
+
+// SystemStart returns time of the first slot.
+func (tc *TimeConverter) SystemStart() time.Time {
+	return tc.systemStart
+}
+
+// Eras returns era history used by the converter.
+func (tc *TimeConverter) Eras() []Era {
+	return append([]Era(nil), tc.eras...)
+}
+
+// SlotToTime returns start time of absolute slot.
+func (tc *TimeConverter) SlotToTime(slot Slot) time.Time {
+	i := tc.eraOfSlot(slot)
+	era := tc.eras[i]
+	return tc.starts[i].Add(time.Duration(slot-era.StartSlot) * era.SlotLength)
+}
+
+// TimeToSlot returns absolute slot containing time t.
+func (tc *TimeConverter) TimeToSlot(t time.Time) (Slot, error) {
+	if t.Before(tc.systemStart) {
+		return 0, fmt.Errorf("%w: time %s is before system start %s", ErrTimeConverter, t, tc.systemStart)
+	}
+	i := len(tc.eras) - 1
+	for i > 0 && t.Before(tc.starts[i]) {
+		i--
+	}
+	era := tc.eras[i]
+	return era.StartSlot + Slot(t.Sub(tc.starts[i])/era.SlotLength), nil
+}
+
+// SlotToEpoch returns epoch of absolute slot and slot within that epoch.
+func (tc *TimeConverter) SlotToEpoch(slot Slot) (EpochNo, Slot) {
+	era := tc.eras[tc.eraOfSlot(slot)]
+	n := uint64(slot - era.StartSlot)
+	return era.StartEpoch + EpochNo(n/era.EpochLength), Slot(n % era.EpochLength)
+}
+
+// EpochStartSlot returns first absolute slot of epoch.
+func (tc *TimeConverter) EpochStartSlot(epoch EpochNo) Slot {
+	era := tc.eras[tc.eraOfEpoch(epoch)]
+	return era.StartSlot + Slot(uint64(epoch-era.StartEpoch)*era.EpochLength)
+}
+
+// EpochLength returns number of slots in epoch.
+func (tc *TimeConverter) EpochLength(epoch EpochNo) uint64 {
+	return tc.eras[tc.eraOfEpoch(epoch)].EpochLength
+}
+
+// EpochStart returns start time of epoch.
+func (tc *TimeConverter) EpochStart(epoch EpochNo) time.Time {
+	return tc.SlotToTime(tc.EpochStartSlot(epoch))
+}
+
+// EpochEnd returns end time of epoch which is start time of next epoch.
+func (tc *TimeConverter) EpochEnd(epoch EpochNo) time.Time {
+	return tc.EpochStart(epoch + 1)
+}
+
+func (tc *TimeConverter) eraOfSlot(slot Slot) int {
+	i := len(tc.eras) - 1
+	for i > 0 && slot < tc.eras[i].StartSlot {
+		i--
+	}
+	return i
+}
+
+func (tc *TimeConverter) eraOfEpoch(epoch EpochNo) int {
+	i := len(tc.eras) - 1
+	for i > 0 && epoch < tc.eras[i].StartEpoch {
+		i--
+	}
+	return i
+}
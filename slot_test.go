@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func testTimeConverter(t *testing.T, n Network) *TimeConverter {
+	t.Helper()
+	tc, err := NewTimeConverter(&Genesis{
+		NetworkMagic: decimal.NewFromInt(int64(n.Magic)),
+		SystemStart:  Timestamp{n.SystemStart},
+		SlotLength:   decimal.NewFromInt(1),
+		EpochLength:  decimal.NewFromInt(432000),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tc
+}
+
+func TestTimeConverterBoundaries(t *testing.T) {
+	tests := []struct {
+		network Network
+		epoch   EpochNo
+		slot    Slot
+		start   time.Time
+		eras    int
+	}{
+		{
+			network: Mainnet,
+			epoch:   208,
+			slot:    4492800,
+			start:   time.Date(2020, 7, 29, 21, 44, 51, 0, time.UTC),
+			eras:    2,
+		},
+		{
+			network: PreProd,
+			epoch:   4,
+			slot:    86400,
+			start:   time.Date(2022, 6, 21, 0, 0, 0, 0, time.UTC),
+			eras:    2,
+		},
+		{
+			network: Preview,
+			epoch:   0,
+			slot:    0,
+			start:   time.Date(2022, 10, 25, 0, 0, 0, 0, time.UTC),
+			eras:    1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.network.Name, func(t *testing.T) {
+			tc := testTimeConverter(t, tt.network)
+			if got := len(tc.Eras()); got != tt.eras {
+				t.Fatalf("expected %d eras got %d", tt.eras, got)
+			}
+			if got := tc.EpochStartSlot(tt.epoch); got != tt.slot {
+				t.Errorf("EpochStartSlot: expected %d got %d", tt.slot, got)
+			}
+			if got := tc.EpochStart(tt.epoch); !got.Equal(tt.start) {
+				t.Errorf("EpochStart: expected %s got %s", tt.start, got)
+			}
+			if got := tc.EpochLength(tt.epoch); got != 432000 {
+				t.Errorf("EpochLength: expected 432000 got %d", got)
+			}
+			if got := tc.EpochEnd(tt.epoch); !got.Equal(tt.start.Add(5 * 24 * time.Hour)) {
+				t.Errorf("EpochEnd: expected %s got %s", tt.start.Add(5*24*time.Hour), got)
+			}
+			if tt.epoch == 0 {
+				return
+			}
+			// last Byron slot is 20 seconds before the boundary.
+			if got := tc.SlotToTime(tt.slot - 1); !got.Equal(tt.start.Add(-byronSlotLength)) {
+				t.Errorf("SlotToTime: expected %s got %s", tt.start.Add(-byronSlotLength), got)
+			}
+			if got := tc.EpochLength(tt.epoch - 1); got != byronEpochLength {
+				t.Errorf("EpochLength: expected %d got %d", byronEpochLength, got)
+			}
+			if e, s := tc.SlotToEpoch(tt.slot - 1); e != tt.epoch-1 || s != byronEpochLength-1 {
+				t.Errorf("SlotToEpoch: expected %d/%d got %d/%d", tt.epoch-1, byronEpochLength-1, e, s)
+			}
+		})
+	}
+}
+
+func TestTimeConverterRoundTrip(t *testing.T) {
+	for _, n := range []Network{Mainnet, PreProd, Preview} {
+		t.Run(n.Name, func(t *testing.T) {
+			tc := testTimeConverter(t, n)
+			boundary := tc.EpochStartSlot(n.ShelleyStartEpoch)
+			slots := []Slot{0, 1, 21599, 21600, boundary + 1, boundary + 432000, 130000000}
+			if boundary > 0 {
+				slots = append(slots, boundary-1, boundary)
+			}
+			for _, slot := range slots {
+				got, err := tc.TimeToSlot(tc.SlotToTime(slot))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != slot {
+					t.Errorf("slot %d: round trip returned %d", slot, got)
+				}
+				e, s := tc.SlotToEpoch(slot)
+				if tc.EpochStartSlot(e)+s != slot {
+					t.Errorf("slot %d: epoch %d slot %d do not add up", slot, e, s)
+				}
+			}
+		})
+	}
+
+	// time inside of Byron slot resolves to that slot.
+	tc := testTimeConverter(t, Mainnet)
+	if got, _ := tc.TimeToSlot(Mainnet.SystemStart.Add(39 * time.Second)); got != 1 {
+		t.Errorf("expected slot 1 got %d", got)
+	}
+	if got, _ := tc.TimeToSlot(time.Date(2020, 7, 29, 21, 44, 50, 0, time.UTC)); got != 4492799 {
+		t.Errorf("expected slot 4492799 got %d", got)
+	}
+}
+
+func TestTimeConverterInvalid(t *testing.T) {
+	tc := testTimeConverter(t, Mainnet)
+	if _, err := tc.TimeToSlot(Mainnet.SystemStart.Add(-time.Second)); !errors.Is(err, ErrTimeConverter) {
+		t.Errorf("expected error for time before system start got %v", err)
+	}
+
+	if _, err := NewTimeConverter(nil); !errors.Is(err, ErrTimeConverter) {
+		t.Errorf("expected error for nil genesis got %v", err)
+	}
+	byron := Era{Name: "byron", SlotLength: byronSlotLength, EpochLength: byronEpochLength}
+	tests := map[string][]Era{
+		"no eras":          nil,
+		"first not zero":   {{Name: "shelley", StartEpoch: 1, StartSlot: 21600, SlotLength: time.Second, EpochLength: 432000}},
+		"zero slot length": {{Name: "byron", EpochLength: byronEpochLength}},
+		"misaligned": {
+			byron,
+			{Name: "shelley", StartEpoch: 208, StartSlot: 4492801, SlotLength: time.Second, EpochLength: 432000},
+		},
+		"not ordered": {
+			byron,
+			{Name: "shelley", StartEpoch: 0, SlotLength: time.Second, EpochLength: 432000},
+		},
+	}
+	for name, eras := range tests {
+		if _, err := NewTimeConverterFromEras(Mainnet.SystemStart, eras...); !errors.Is(err, ErrTimeConverter) {
+			t.Errorf("%s: expected ErrTimeConverter got %v", name, err)
+		}
+	}
+}
@@ -54,31 +54,16 @@ type (
 	}
 
 	// AccountListResponse represents response from `/account_list` endpoint.
-	AccountListResponse struct {
-		Response
-		Data []Address `json:"data"`
-	}
+	AccountListResponse = ListResponse[Address]
 
 	// AccountInfoResponse represents response from `/account_info` endpoint.
-	AccountInfoResponse struct {
-		Response
-		Data *AccountInfo `json:"data"`
-	}
+	AccountInfoResponse = Response[*AccountInfo]
 
-	AccountsInfoResponse struct {
-		Response
-		Data []AccountInfo `json:"data"`
-	}
+	AccountsInfoResponse = ListResponse[AccountInfo]
 
 	// AccountRewardsResponse represents response from `/account_rewards` endpoint.
-	AccountRewardsResponse struct {
-		Response
-		Data *AccountRewardsInfo `json:"data"`
-	}
-	AccountsRewardsResponse struct {
-		Response
-		Data []AccountRewardsInfo `json:"data"`
-	}
+	AccountRewardsResponse  = Response[*AccountRewardsInfo]
+	AccountsRewardsResponse = ListResponse[AccountRewardsInfo]
 
 	// AccountAction data entry for `/account_updates`.
 	AccountUpdate struct {
@@ -96,40 +81,22 @@ type (
 	}
 
 	// AccountUpdatesResponse represents response from `/account_rewards` endpoint.
-	AccountUpdatesResponse struct {
-		Response
-		Data *AccountUpdates `json:"data"`
-	}
+	AccountUpdatesResponse = Response[*AccountUpdates]
 
-	AccountsUpdatesResponse struct {
-		Response
-		Data []AccountUpdates `json:"data"`
-	}
+	AccountsUpdatesResponse = ListResponse[AccountUpdates]
 
 	AccountAddresses struct {
 		StakeAddress Address   `json:"stake_address"`
 		Addresses    []Address `json:"addresses"`
 	}
 	// AccountAddressesResponse represents response from `/account_addresses` endpoint.
-	AccountAddressesResponse struct {
-		Response
-		Data *AccountAddresses `json:"data"`
-	}
-	AccountsAddressesResponse struct {
-		Response
-		Data []AccountAddresses `json:"data"`
-	}
+	AccountAddressesResponse  = Response[*AccountAddresses]
+	AccountsAddressesResponse = ListResponse[AccountAddresses]
 
 	// AccountAssetsResponse represents response from `/account_assets` endpoint.
-	AccountAssetsResponse struct {
-		Response
-		Data *AccountAssets `json:"data"`
-	}
+	AccountAssetsResponse = Response[*AccountAssets]
 
-	AccountsAssetsResponse struct {
-		Response
-		Data []AccountAssets `json:"data"`
-	}
+	AccountsAssetsResponse = ListResponse[AccountAssets]
 
 	AccountAssets struct {
 		StakeAddress Address `json:"stake_address"`
@@ -137,19 +104,10 @@ type (
 	}
 
 	// AccountHistoryResponse represents response from `/account_history` endpoint.
-	AccountHistoryResponse struct {
-		Response
-		Data *AccountHistory `json:"data"`
-	}
-	AccountsHistoryResponse struct {
-		Response
-		Data []AccountHistory `json:"data"`
-	}
+	AccountHistoryResponse  = Response[*AccountHistory]
+	AccountsHistoryResponse = ListResponse[AccountHistory]
 
-	AccountTXsResponse struct {
-		Response
-		Data []TxListItem `json:"data"`
-	}
+	AccountTXsResponse = ListResponse[TxListItem]
 )
 
 // GetAccountList returns a list of all accounts.
@@ -158,7 +116,7 @@ func (c *Client) GetAccountList(
 	opts *RequestOptions,
 ) (res *AccountListResponse, err error) {
 	res = &AccountListResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/account_list", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/account_list", nil, opts)
 	if err != nil {
 		return
 	}
@@ -167,7 +125,7 @@ func (c *Client) GetAccountList(
 		ID Address `json:"id"`
 	}{}
 
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &accs)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &accs)
 
 	if len(accs) > 0 {
 		for _, a := range accs {
@@ -189,13 +147,21 @@ func (c *Client) GetAccountInfo(
 		res.applyError(nil, err)
 		return
 	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
 	endpoint := "/account_info"
 
-	rsp, err := c.request(ctx, &res.Response, "POST", endpoint, stakeAddressesPL(accs, nil, nil), opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", endpoint, stakeAddressesPL(accs, nil, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -210,13 +176,21 @@ func (c *Client) GetAccountInfoCached(
 		res.applyError(nil, err)
 		return
 	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
 	endpoint := "/account_info_cached"
 
-	rsp, err := c.request(ctx, &res.Response, "POST", endpoint, stakeAddressesPL(accs, nil, nil), opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", endpoint, stakeAddressesPL(accs, nil, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -234,15 +208,24 @@ func (c *Client) GetAccountRewards(
 		res.applyError(nil, err)
 		return
 	}
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+		v.epoch("_epoch_no", epoch)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
 	var epochNo *EpochNo
 	if epoch > 0 {
 		epochNo = &epoch
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_rewards", stakeAddressesPL(accs, epochNo, nil), opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_rewards", stakeAddressesPL(accs, epochNo, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -259,11 +242,19 @@ func (c *Client) GetAccountUpdates(
 		res.applyError(nil, err)
 		return
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_updates", stakeAddressesPL(accs, nil, nil), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_updates", stakeAddressesPL(accs, nil, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -280,11 +271,19 @@ func (c *Client) GetAccountAddresses(
 		res.applyError(nil, err)
 		return
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_addresses", stakeAddresses2PL(accs, firstOnly, empty), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_addresses", stakeAddresses2PL(accs, firstOnly, empty), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -300,11 +299,19 @@ func (c *Client) GetAccountAssets(
 		res.applyError(nil, err)
 		return
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_assets", stakeAddressesPL(accs, nil, nil), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_assets", stakeAddressesPL(accs, nil, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -321,11 +328,22 @@ func (c *Client) GetAccountHistory(
 		res.applyError(nil, err)
 		return
 	}
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_history", stakeAddressesPL(accs, epoch, nil), opts)
+
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+		if epoch != nil {
+			v.epoch("_epoch_no", *epoch)
+		}
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_history", stakeAddressesPL(accs, epoch, nil), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -342,11 +360,18 @@ func (c *Client) GetAccountUtxos(
 		return
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/account_utxos", stakeAddressesPL(accs, nil, &extended), opts)
+	if err = c.validate(func(v *validator) {
+		validateList(v, "_stake_addresses", accs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/account_utxos", stakeAddressesPL(accs, nil, &extended), opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
@@ -358,16 +383,24 @@ func (c *Client) GetAccountTxs(
 ) (res *AccountTXsResponse, err error) {
 	res = &AccountTXsResponse{}
 
+	if err = c.validate(func(v *validator) {
+		v.value("_stake_address", acc)
+	}); err != nil {
+		res.applyError(nil, err)
+		return
+	}
+
+	opts = c.requestOptions(opts)
 	opts.QueryAdd("_stake_address", acc.String())
 	if afterBlockHeight > 0 {
 		opts.QueryAdd("after_block_height", fmt.Sprint(afterBlockHeight))
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "GET", "/account_txs", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/account_txs", nil, opts)
 	if err != nil {
 		return
 	}
-	err = ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	err = ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 	return
 }
 
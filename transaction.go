@@ -129,28 +129,56 @@ type (
 	}
 
 	// TxsInfosResponse represents response from `/tx_info` endpoint.
-	TxsInfosResponse struct {
-		Response
-		Data []TX `json:"data"`
-	}
+	TxsInfosResponse = ListResponse[TX]
 
 	// TxInfoResponse represents response from `/tx_info` endpoint.
 	// when requesting info about single transaction.
-	TxInfoResponse struct {
-		Response
-		Data TX `json:"data"`
-	}
+	TxInfoResponse = Response[*TX]
 
 	// TxUTxOsResponse represents response from `/tx_utxos` endpoint.
-	TxUTxOsResponse struct {
-		Response
-		Data *EUTxO `json:"data"`
-	}
+	TxUTxOsResponse = Response[*EUTxO]
 
 	// TxsUTxOsResponse represents response from `/tx_utxos` endpoint.
-	TxsUTxOsResponse struct {
-		Response
-		Data []EUTxO `json:"data"`
+	TxsUTxOsResponse = ListResponse[EUTxO]
+
+	// TxCBOR raw transaction in CBOR format.
+	TxCBOR struct {
+		// TxHash is hash of transaction.
+		TxHash TxHash `json:"tx_hash"`
+		// BlockHash is hash of the block in which transaction was included.
+		BlockHash BlockHash `json:"block_hash"`
+		// BlockHeight is block number on chain where transaction was included.
+		BlockHeight uint64 `json:"block_height"`
+		// Epoch number.
+		EpochNo EpochNo `json:"epoch_no"`
+		// AbsoluteSlot is overall slot number (slots from genesis block of chain).
+		AbsoluteSlot Slot `json:"absolute_slot"`
+		// TxTimestamp is timestamp when block containing transaction was created.
+		TxTimestamp Timestamp `json:"tx_timestamp"`
+		// CBOR is hex encoded raw transaction.
+		CBOR string `json:"cbor"`
+	}
+
+	// TxsCBORResponse represents response from `/tx_cbor` endpoint.
+	TxsCBORResponse = ListResponse[TxCBOR]
+
+	// TxInfoFlags controls which details are included in transaction info.
+	// Omitting details which are not needed reduces response size.
+	TxInfoFlags struct {
+		// Inputs include collateral and reference inputs.
+		Inputs bool
+		// Metadata include transaction metadata.
+		Metadata bool
+		// Assets include assets minted or contained in outputs.
+		Assets bool
+		// Withdrawals include reward withdrawals.
+		Withdrawals bool
+		// Certs include certificates.
+		Certs bool
+		// Scripts include native scripts, plutus contracts, datums and redeemers.
+		Scripts bool
+		// Bytecode include bytecode of the scripts, requires Scripts.
+		Bytecode bool
 	}
 
 	// TxMetadata transaction metadata lookup res for `/tx_metadata` endpoint.
@@ -162,10 +190,7 @@ type (
 	}
 
 	// SubmitSignedTxResponse represents response from `/submittx` endpoint.
-	SubmitSignedTxResponse struct {
-		Response
-		Data TxHash `json:"data"`
-	}
+	SubmitSignedTxResponse = Response[TxHash]
 
 	// TxBodyJSON used to Unmarshal built transactions.
 	TxBodyJSON struct {
@@ -174,21 +199,12 @@ type (
 		CborHex     string `json:"cborHex"`
 	}
 	// TxMetadataResponse represents response from `/tx_metadata` endpoint.
-	TxMetadataResponse struct {
-		Response
-		Data *TxMetadataOf `json:"data"`
-	}
+	TxMetadataResponse = Response[*TxMetadataOf]
 
 	// TxsMetadataResponse represents response from `/tx_metadata` endpoint.
-	TxsMetadataResponse struct {
-		Response
-		Data []TxMetadataOf `json:"data"`
-	}
+	TxsMetadataResponse = ListResponse[TxMetadataOf]
 	// TxMetaLabelsResponse represents response from `/tx_metalabels` endpoint.
-	TxMetaLabelsResponse struct {
-		Response
-		Data []TxMetalabel `json:"data"`
-	}
+	TxMetaLabelsResponse = ListResponse[TxMetalabel]
 
 	// TxStatus is tx_status enpoint response.
 	TxStatus struct {
@@ -197,23 +213,14 @@ type (
 	}
 
 	// TxsStatusesResponse represents response from `/tx_status` endpoint.
-	TxsStatusesResponse struct {
-		Response
-		Data []TxStatus `json:"data"`
-	}
+	TxsStatusesResponse = ListResponse[TxStatus]
 
 	// TxStatusResponse represents response from `/tx_status` endpoint.
-	TxStatusResponse struct {
-		Response
-		Data *TxStatus `json:"data"`
-	}
+	TxStatusResponse = Response[*TxStatus]
 
 	UTxORef string
 
-	UTxOInfoResponse struct {
-		Response
-		Data []UTxO `json:"data"`
-	}
+	UTxOInfoResponse = ListResponse[UTxO]
 )
 
 // GetTxInfo returns detailed information about transaction.
@@ -229,11 +236,18 @@ func (c *Client) GetTxInfo(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/tx_info", txHashesPL(txs), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_tx_hashes", txs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/tx_info", txHashesPL(txs), opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 // GetTxMetadata returns metadata information (if any) for given transaction.
@@ -249,12 +263,19 @@ func (c *Client) GetTxMetadata(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/tx_metadata", txHashesPL(txs), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_tx_hashes", txs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/tx_metadata", txHashesPL(txs), opts)
 	if err != nil {
 		return res, err
 	}
 
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 // GetTxMetaLabels retruns a list of all transaction metalabels.
@@ -263,11 +284,11 @@ func (c *Client) GetTxMetaLabels(
 	opts *RequestOptions,
 ) (*TxMetaLabelsResponse, error) {
 	res := &TxMetaLabelsResponse{}
-	rsp, err := c.request(ctx, &res.Response, "GET", "/tx_metalabels", nil, opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, "GET", "/tx_metalabels", nil, opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 // SubmitSignedTx Submit an transaction to the network.
@@ -287,13 +308,11 @@ func (c *Client) SubmitSignedTx(
 		return res, err
 	}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
-	}
+	opts = c.requestOptions(opts)
 	opts.HeaderSet("Content-Type", "application/cbor")
 	opts.HeaderSet("Content-Length", fmt.Sprint(len(cborb)))
 
-	rsp, err := c.request(ctx, &res.Response, method, "/submittx", bytes.NewBuffer(cborb), opts)
+	rsp, err := c.request(ctx, &res.ResponseMeta, method, "/submittx", bytes.NewBuffer(cborb), opts)
 	if err != nil {
 		body, _ := ReadResponseBody(rsp)
 		res.applyError(body, err)
@@ -317,11 +336,18 @@ func (c *Client) GetTxStatus(
 		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/tx_status", txHashesPL(txs), opts)
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_tx_hashes", txs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/tx_status", txHashesPL(txs), opts)
 	if err != nil {
 		return res, err
 	}
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func (c *Client) GetUTxOInfo(
@@ -337,17 +363,92 @@ func (c *Client) GetUTxOInfo(
 		return res, err
 	}
 
-	if opts == nil {
-		opts = c.NewRequestOptions()
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_utxo_refs", refs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
 	}
 
-	rsp, err := c.request(ctx, &res.Response, "POST", "/utxo_info", utxoRefsPL(refs, extended), opts)
+	opts = c.requestOptions(opts)
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/utxo_info", utxoRefsPL(refs, extended), opts)
 	if err != nil {
 		res.applyError(nil, err)
 		return res, err
 	}
 
-	return res, ReadAndUnmarshalResponse(rsp, &res.Response, &res.Data)
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+}
+
+// GetTxCBOR returns raw transactions in CBOR format.
+func (c *Client) GetTxCBOR(
+	ctx context.Context,
+	txs []TxHash,
+	opts *RequestOptions,
+) (*TxsCBORResponse, error) {
+	res := &TxsCBORResponse{}
+	if len(txs) == 0 || len(txs[0]) == 0 {
+		err := ErrNoTxHash
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_tx_hashes", txs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/tx_cbor", txHashesPL(txs), opts)
+	if err != nil {
+		return res, err
+	}
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
+}
+
+// GetTxUTxOs returns UTxO set (inputs/outputs) of given transaction.
+func (c *Client) GetTxUTxOs(
+	ctx context.Context,
+	tx TxHash,
+	opts *RequestOptions,
+) (*TxUTxOsResponse, error) {
+	res := &TxUTxOsResponse{}
+	rsp, err := c.GetTxsUTxOs(ctx, []TxHash{tx}, opts)
+	res.ResponseMeta = rsp.ResponseMeta
+	if err != nil {
+		return res, err
+	}
+	res.Data, err = firstItem(rsp.Data, "tx %s", tx)
+	return res, err
+}
+
+// GetTxsUTxOs returns UTxO set (inputs/outputs) of given transactions.
+func (c *Client) GetTxsUTxOs(
+	ctx context.Context,
+	txs []TxHash,
+	opts *RequestOptions,
+) (*TxsUTxOsResponse, error) {
+	res := &TxsUTxOsResponse{}
+	if len(txs) == 0 || len(txs[0]) == 0 {
+		err := ErrNoTxHash
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	if err := c.validate(func(v *validator) {
+		validateList(v, "_tx_hashes", txs)
+	}); err != nil {
+		res.applyError(nil, err)
+		return res, err
+	}
+
+	rsp, err := c.request(ctx, &res.ResponseMeta, "POST", "/tx_utxos", txHashesPL(txs), opts)
+	if err != nil {
+		return res, err
+	}
+	return res, ReadAndUnmarshalResponse(rsp, &res.ResponseMeta, &res.Data)
 }
 
 func txHashesPL(txs []TxHash) io.Reader {
@@ -0,0 +1,403 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// ValidationError is returned by endpoints when inputs are invalid.
+	// Request is not sent when validation fails.
+	ValidationError struct {
+		Inputs []InvalidInput `json:"inputs"`
+	}
+
+	// InvalidInput describes single invalid input.
+	InvalidInput struct {
+		// Param is name of the api parameter e.g. _tx_hashes.
+		Param string `json:"param"`
+		// Index is position of the input in the list, -1 for single value parameters.
+		Index int    `json:"index"`
+		Value string `json:"value"`
+		Err   error  `json:"-"`
+	}
+
+	// validator collects invalid inputs of single request.
+	validator struct {
+		tip    *Tip
+		inputs []InvalidInput
+	}
+
+	// committeeHotID is bech32 encoded hot credential of committee member.
+	committeeHotID string
+
+	// validatable is implemented by input types checked by validator.
+	validatable interface {
+		String() string
+		validate() error
+	}
+
+	// lastTip holds last tip seen by the client,
+	// used to validate epoch numbers.
+	lastTip struct {
+		p atomic.Pointer[seenTip]
+	}
+
+	seenTip struct {
+		tip Tip
+		at  time.Time
+	}
+)
+
+const (
+	txHashLen     = 32
+	blockHashLen  = 32
+	datumHashLen  = 32
+	scriptHashLen = 28
+
+	hrpDRep         = "drep"
+	hrpDRepScript   = "drep_script"
+	hrpCCHot        = "cc_hot"
+	hrpCCHotScript  = "cc_hot_script"
+	hrpGovAction    = "gov_action"
+	cip129KeyDRep   = 0x20
+	cip129KeyCCHot  = 0x00
+	maxGovActionIdx = 4
+	// tipMaxAge is time for which last seen tip is used to validate
+	// epoch numbers. Tip may be that old when epoch boundary passes,
+	// so epoch following the tip epoch is always accepted.
+	tipMaxAge = 5 * time.Minute
+)
+
+// Validation enables or disables validation of endpoint inputs
+// before request is sent. It is enabled by default.
+func Validation(enable bool) Option {
+	return Option{
+		apply: func(c *config) error {
+			c.noValidation = !enable
+			return nil
+		},
+	}
+}
+
+// Error returns all invalid inputs.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Inputs))
+	for i, in := range e.Inputs {
+		msgs[i] = in.String()
+	}
+	return fmt.Sprintf("%s: %s", ErrValidation, strings.Join(msgs, "; "))
+}
+
+// Unwrap makes ValidationError match ErrValidation and errors of all invalid inputs.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Inputs)+1)
+	errs = append(errs, ErrValidation)
+	for _, in := range e.Inputs {
+		errs = append(errs, in.Err)
+	}
+	return errs
+}
+
+// String returns description of invalid input.
+func (in InvalidInput) String() string {
+	name := in.Param
+	if in.Index >= 0 {
+		name = fmt.Sprintf("%s[%d]", in.Param, in.Index)
+	}
+	return fmt.Sprintf("%s %q: %s", name, in.Value, in.Err)
+}
+
+// Valid validates transaction hash and returns false and error
+// if hash is invalid otherwise it returns true, nil.
+func (v TxHash) Valid() (bool, error) {
+	if err := v.validate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Valid validates block hash and returns false and error
+// if hash is invalid otherwise it returns true, nil.
+func (v BlockHash) Valid() (bool, error) {
+	if err := v.validate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Valid validates datum hash and returns false and error
+// if hash is invalid otherwise it returns true, nil.
+func (v DatumHash) Valid() (bool, error) {
+	if err := v.validate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Valid validates script hash and returns false and error
+// if hash is invalid otherwise it returns true, nil.
+func (v ScriptHash) Valid() (bool, error) {
+	if err := v.validate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Valid validates UTxO reference and returns false and error
+// if reference is invalid otherwise it returns true, nil.
+func (v UTxORef) Valid() (bool, error) {
+	if err := v.validate(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Parse returns transaction hash and output index of UTxO reference.
+func (v UTxORef) Parse() (TxHash, uint32, error) {
+	hash, index, ok := strings.Cut(string(v), "#")
+	if !ok {
+		return "", 0, fmt.Errorf("%w: expected hash#index", ErrUTxORef)
+	}
+	tx := TxHash(hash)
+	if err := tx.validate(); err != nil {
+		return "", 0, fmt.Errorf("%w: %s", ErrUTxORef, err.Error())
+	}
+	i, err := strconv.ParseUint(index, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: invalid output index %q", ErrUTxORef, index)
+	}
+	return tx, uint32(i), nil
+}
+
+// String returns UTxORef as string.
+func (v UTxORef) String() string {
+	return string(v)
+}
+
+func (v TxHash) validate() error {
+	return validateHex(string(v), txHashLen, ErrTxHash)
+}
+
+func (v BlockHash) validate() error {
+	return validateHex(string(v), blockHashLen, ErrBlockHash)
+}
+
+func (v DatumHash) validate() error {
+	return validateHex(string(v), datumHashLen, ErrDatumHash)
+}
+
+func (v ScriptHash) validate() error {
+	return validateHex(string(v), scriptHashLen, ErrScriptHash)
+}
+
+func (v PaymentCredential) validate() error {
+	return validateHex(string(v), credentialLen, ErrCredential)
+}
+
+func (v UTxORef) validate() error {
+	_, _, err := v.Parse()
+	return err
+}
+
+func (a Address) validate() error {
+	_, err := a.Decode()
+	return err
+}
+
+func (v PoolID) validate() error {
+	_, err := v.decode()
+	return err
+}
+
+func (v PolicyID) validate() error {
+	_, err := v.bytes()
+	return err
+}
+
+func (v AssetName) validate() error {
+	_, err := v.bytes()
+	return err
+}
+
+// validate checks CIP-129 or CIP-105 drep id or predefined DRep.
+func (v DRepID) validate() error {
+	if v == DRepAlwaysAbstain || v == DRepAlwaysNoConfidence {
+		return nil
+	}
+	return validateGovCredential(string(v), hrpDRep, hrpDRepScript, cip129KeyDRep, ErrDRepID)
+}
+
+// validate checks CIP-129 governance action id, transaction hash
+// followed by index of the action in the transaction.
+func (v ProposalID) validate() error {
+	hrp, b, err := bech32Decode(string(v))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrProposalID, err.Error())
+	}
+	if hrp != hrpGovAction {
+		return fmt.Errorf("%w: expected prefix %s got %s", ErrProposalID, hrpGovAction, hrp)
+	}
+	if len(b) <= txHashLen || len(b) > txHashLen+maxGovActionIdx {
+		return fmt.Errorf("%w: invalid length %d", ErrProposalID, len(b))
+	}
+	return nil
+}
+
+func (v committeeHotID) String() string {
+	return string(v)
+}
+
+// validate checks CIP-129 or CIP-105 committee hot credential.
+func (v committeeHotID) validate() error {
+	return validateGovCredential(string(v), hrpCCHot, hrpCCHotScript, cip129KeyCCHot, ErrCCHotID)
+}
+
+// validateGovCredential checks bech32 governance credential which is either
+// CIP-129 header byte followed by credential or CIP-105 credential only,
+// where script credentials use separate prefix.
+func validateGovCredential(s, hrp, scriptHRP string, keyType byte, kind error) error {
+	prefix, b, err := bech32Decode(s)
+	if err != nil {
+		return fmt.Errorf("%w: %s", kind, err.Error())
+	}
+	switch {
+	case prefix != hrp && prefix != scriptHRP:
+		return fmt.Errorf("%w: expected prefix %s or %s got %s", kind, hrp, scriptHRP, prefix)
+	case len(b) == credentialLen:
+		return nil
+	case prefix == hrp && len(b) == credentialLen+1:
+		// key type in high bits, 2 for key hash or 3 for script hash in low bits.
+		if b[0]&0xf0 != keyType || (b[0]&0x0f != 2 && b[0]&0x0f != 3) {
+			return fmt.Errorf("%w: invalid header %#02x", kind, b[0])
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: invalid length %d", kind, len(b))
+}
+
+// validate checks asset identified by policy id and asset name,
+// unit set as policy id or by fingerprint only.
+func (a Asset) validate() error {
+	switch {
+	case len(a.PolicyID) == 0 && len(a.Fingerprint) == 0:
+		return fmt.Errorf("%w: policy_id and asset_name or fingerprint must be provided", ErrAsset)
+	case len(a.PolicyID) == 0:
+		_, err := a.Fingerprint.Valid()
+		return err
+	case len(a.PolicyID) > policyIDLen*2 && len(a.AssetName) == 0:
+		_, err := ParseAssetID(a.PolicyID.String())
+		return err
+	}
+	if err := a.PolicyID.validate(); err != nil {
+		return err
+	}
+	return a.AssetName.validate()
+}
+
+// assetValue returns unit of the asset or fingerprint when policy id is not set.
+func assetValue(a Asset) string {
+	if len(a.PolicyID) == 0 {
+		return a.Fingerprint.String()
+	}
+	return a.Unit()
+}
+
+// validateHex checks that s is hex encoded value of n bytes.
+func validateHex(s string, n int, kind error) error {
+	if len(s) != n*2 {
+		return fmt.Errorf("%w: expected %d hex characters got %d", kind, n*2, len(s))
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return fmt.Errorf("%w: %s", kind, err.Error())
+	}
+	return nil
+}
+
+// validate runs checks on inputs of the request unless validation is disabled.
+func (c *Client) validate(checks func(v *validator)) error {
+	cfg := c.config()
+	if cfg.noValidation {
+		return nil
+	}
+	v := &validator{tip: cfg.tip.get()}
+	checks(v)
+	if len(v.inputs) == 0 {
+		return nil
+	}
+	return &ValidationError{Inputs: v.inputs}
+}
+
+func (v *validator) add(param string, index int, value string, err error) {
+	if err == nil {
+		return
+	}
+	v.inputs = append(v.inputs, InvalidInput{Param: param, Index: index, Value: value, Err: err})
+}
+
+// value validates single value parameter.
+func (v *validator) value(param string, val validatable) {
+	v.add(param, -1, val.String(), val.validate())
+}
+
+// epoch validates that epoch is not beyond epoch following recently seen tip.
+// Zero epoch means that epoch was not specified.
+func (v *validator) epoch(param string, epoch EpochNo) {
+	// new epoch may have started since the tip was seen.
+	if epoch == 0 || v.tip == nil || epoch <= v.tip.EpochNo+1 {
+		return
+	}
+	v.add(param, -1, epoch.String(),
+		fmt.Errorf("%w: epoch is beyond next epoch %d", ErrEpochNo, v.tip.EpochNo+1))
+}
+
+// epochs validates that bounds of epoch range are not beyond recently seen tip.
+func (v *validator) epochs(param string, r EpochRange) {
+	v.epoch(param, r.From)
+	v.epoch(param, r.To)
+}
+
+// asset validates single asset.
+func (v *validator) asset(param string, a Asset) {
+	v.add(param, -1, assetValue(a), a.validate())
+}
+
+// assets validates list of assets.
+func (v *validator) assets(param string, assets []Asset) {
+	for i, a := range assets {
+		v.add(param, i, assetValue(a), a.validate())
+	}
+}
+
+// validateList validates all values of list parameter.
+func validateList[T validatable](v *validator, param string, list []T) {
+	for i, val := range list {
+		v.add(param, i, val.String(), val.validate())
+	}
+}
+
+func (lt *lastTip) get() *Tip {
+	if lt == nil {
+		return nil
+	}
+	seen := lt.p.Load()
+	if seen == nil || time.Since(seen.at) > tipMaxAge {
+		return nil
+	}
+	return &seen.tip
+}
+
+func (lt *lastTip) set(tip *Tip) {
+	if lt == nil || tip == nil {
+		return
+	}
+	lt.p.Store(&seenTip{tip: *tip, at: time.Now()})
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	validTxHash = "f144a8264acf4bdfe2e1241170969c930d64ab6b0996a4a45237b623f1dd670e"
+	validTxRef  = UTxORef(validTxHash + "#1")
+)
+
+func TestValidationErrorUnwrap(t *testing.T) {
+	c, srv := newNoHitClient(t)
+	defer srv.Close()
+
+	_, err := c.GetTxInfo(context.Background(), []TxHash{validTxHash, "abc", "zz"}, nil)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError got %v", err)
+	}
+	if !errors.Is(err, ErrValidation) {
+		t.Error("expected error to match ErrValidation")
+	}
+	if !errors.Is(err, ErrTxHash) {
+		t.Error("expected error to match ErrTxHash")
+	}
+	if errors.Is(err, ErrUTxORef) {
+		t.Error("unexpected match of ErrUTxORef")
+	}
+	if got := len(verr.Unwrap()); got != 3 {
+		t.Errorf("expected 3 unwrapped errors got %d", got)
+	}
+	if len(verr.Inputs) != 2 {
+		t.Fatalf("expected 2 invalid inputs got %d", len(verr.Inputs))
+	}
+	for i, want := range []string{`_tx_hashes[1] "abc"`, `_tx_hashes[2] "zz"`} {
+		if got := verr.Inputs[i].String(); !strings.HasPrefix(got, want) {
+			t.Errorf("input %d: expected prefix %s got %s", i, want, got)
+		}
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error %q to contain %s", err.Error(), want)
+		}
+	}
+}
+
+func TestInvalidInputString(t *testing.T) {
+	in := InvalidInput{Param: "_epoch_no", Index: -1, Value: "9", Err: ErrEpochNo}
+	if got, want := in.String(), `_epoch_no "9": invalid epoch`; got != want {
+		t.Errorf("expected %s got %s", want, got)
+	}
+}
+
+func TestUTxORefParse(t *testing.T) {
+	tests := []struct {
+		ref   UTxORef
+		hash  TxHash
+		index uint32
+		err   error
+	}{
+		{ref: validTxRef, hash: validTxHash, index: 1},
+		{ref: validTxHash + "#0", hash: validTxHash, index: 0},
+		{ref: validTxHash + "#4294967295", hash: validTxHash, index: 4294967295},
+		{ref: validTxHash, err: ErrUTxORef},
+		{ref: validTxHash + "#", err: ErrUTxORef},
+		{ref: validTxHash + "#-1", err: ErrUTxORef},
+		{ref: validTxHash + "#4294967296", err: ErrUTxORef},
+		{ref: "abc#0", err: ErrUTxORef},
+		{ref: "", err: ErrUTxORef},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.ref), func(t *testing.T) {
+			hash, index, err := tt.ref.Parse()
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v got %v", tt.err, err)
+				}
+				if !errors.Is(err, ErrUTxORef) {
+					t.Errorf("expected error to match ErrUTxORef got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hash != tt.hash || index != tt.index {
+				t.Errorf("expected %s#%d got %s#%d", tt.hash, tt.index, hash, index)
+			}
+		})
+	}
+}
+
+func TestValidateHex(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		n    int
+		err  bool
+	}{
+		{name: "valid", s: validTxHash, n: txHashLen},
+		{name: "uppercase", s: strings.ToUpper(validTxHash), n: txHashLen},
+		{name: "credential", s: validTxHash[:credentialLen*2], n: credentialLen},
+		{name: "empty", s: "", n: txHashLen, err: true},
+		{name: "short", s: validTxHash[:62], n: txHashLen, err: true},
+		{name: "long", s: validTxHash + "00", n: txHashLen, err: true},
+		{name: "odd", s: validTxHash[:63], n: txHashLen, err: true},
+		{name: "non hex", s: "z" + validTxHash[1:], n: txHashLen, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHex(tt.s, tt.n, ErrTxHash)
+			if tt.err != errors.Is(err, ErrTxHash) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !tt.err && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestValidationDisabled(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer srv.Close()
+
+	c, err := New(BaseURL(srv.URL+"/api/v1/"), Validation(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetTxInfo(context.Background(), []TxHash{"abc"}, nil); errors.Is(err, ErrValidation) {
+		t.Fatalf("unexpected validation error %v", err)
+	}
+	if hits.Load() != 1 {
+		t.Errorf("expected request to be sent, got %d requests", hits.Load())
+	}
+}
+
+func TestValidationNoRequest(t *testing.T) {
+	c, srv := newNoHitClient(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	calls := map[string]func() error{
+		"GetTxInfo": func() error {
+			_, err := c.GetTxInfo(ctx, []TxHash{"abc"}, nil)
+			return err
+		},
+		"GetDRepInfo": func() error {
+			_, err := c.GetDRepInfo(ctx, []DRepID{"drep1invalid"}, nil)
+			return err
+		},
+		"GetDRepVotes": func() error {
+			_, err := c.GetDRepVotes(ctx, "pool1invalid", nil)
+			return err
+		},
+		"GetProposalVotes": func() error {
+			_, err := c.GetProposalVotes(ctx, "gov_action1invalid", nil)
+			return err
+		},
+		"GetCommitteeVotes": func() error {
+			_, err := c.GetCommitteeVotes(ctx, "cc_hot1invalid", nil)
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(); !errors.Is(err, ErrValidation) {
+				t.Errorf("expected validation error got %v", err)
+			}
+		})
+	}
+}
+
+func TestGovernanceIDs(t *testing.T) {
+	cred := mustHex(t, validTxHash[:credentialLen*2])
+	txHash := mustHex(t, validTxHash)
+	enc := func(hrp string, b ...[]byte) string {
+		var data []byte
+		for _, p := range b {
+			data = append(data, p...)
+		}
+		s, err := bech32Encode(hrp, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name string
+		val  validatable
+		err  error
+	}{
+		{name: "drep cip129 key", val: DRepID(enc(hrpDRep, []byte{0x22}, cred))},
+		{name: "drep cip129 script", val: DRepID(enc(hrpDRep, []byte{0x23}, cred))},
+		{name: "drep cip105 key", val: DRepID(enc(hrpDRep, cred))},
+		{name: "drep cip105 script", val: DRepID(enc(hrpDRepScript, cred))},
+		{name: "drep always abstain", val: DRepAlwaysAbstain},
+		{name: "drep always no confidence", val: DRepAlwaysNoConfidence},
+		{name: "drep cc header", val: DRepID(enc(hrpDRep, []byte{0x12}, cred)), err: ErrDRepID},
+		{name: "drep invalid cred type", val: DRepID(enc(hrpDRep, []byte{0x24}, cred)), err: ErrDRepID},
+		{name: "drep script header", val: DRepID(enc(hrpDRepScript, []byte{0x23}, cred)), err: ErrDRepID},
+		{name: "drep prefix", val: DRepID(enc(hrpCCHot, cred)), err: ErrDRepID},
+		{name: "drep short", val: DRepID(enc(hrpDRep, cred[1:])), err: ErrDRepID},
+		{name: "drep checksum", val: DRepID("drep1invalid"), err: ErrDRepID},
+		{name: "cc hot cip129 key", val: committeeHotID(enc(hrpCCHot, []byte{0x02}, cred))},
+		{name: "cc hot cip129 script", val: committeeHotID(enc(hrpCCHot, []byte{0x03}, cred))},
+		{name: "cc hot cold header", val: committeeHotID(enc(hrpCCHot, []byte{0x12}, cred)), err: ErrCCHotID},
+		{name: "cc hot cip105", val: committeeHotID(enc(hrpCCHotScript, cred))},
+		{name: "cc hot drep header", val: committeeHotID(enc(hrpCCHot, []byte{0x22}, cred)), err: ErrCCHotID},
+		{name: "cc hot prefix", val: committeeHotID(enc(hrpDRep, cred)), err: ErrCCHotID},
+		{name: "proposal", val: ProposalID(enc(hrpGovAction, txHash, []byte{0}))},
+		{name: "proposal index", val: ProposalID(enc(hrpGovAction, txHash, []byte{1, 2}))},
+		{name: "proposal no index", val: ProposalID(enc(hrpGovAction, txHash)), err: ErrProposalID},
+		{name: "proposal long index", val: ProposalID(enc(hrpGovAction, txHash, []byte{1, 2, 3, 4, 5})), err: ErrProposalID},
+		{name: "proposal prefix", val: ProposalID(enc(hrpDRep, txHash, []byte{0})), err: ErrProposalID},
+		{name: "proposal checksum", val: ProposalID("gov_action1invalid"), err: ErrProposalID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.val.validate()
+			if tt.err == nil && err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestValidatorEpoch(t *testing.T) {
+	tip := &Tip{EpochNo: 500}
+	tests := []struct {
+		name  string
+		tip   *Tip
+		epoch EpochNo
+		err   bool
+	}{
+		{name: "unset", tip: tip, epoch: 0},
+		{name: "past", tip: tip, epoch: 499},
+		{name: "current", tip: tip, epoch: 500},
+		{name: "after boundary", tip: tip, epoch: 501},
+		{name: "beyond", tip: tip, epoch: 502, err: true},
+		{name: "no tip", epoch: 100000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{tip: tt.tip}
+			v.epoch("_epoch_no", tt.epoch)
+			if got := len(v.inputs) > 0; got != tt.err {
+				t.Fatalf("expected error %t got %v", tt.err, v.inputs)
+			}
+			if tt.err && !errors.Is(v.inputs[0].Err, ErrEpochNo) {
+				t.Errorf("expected ErrEpochNo got %v", v.inputs[0].Err)
+			}
+		})
+	}
+}
+
+// newNoHitClient returns client with server failing the test on any request.
+func newNoHitClient(t *testing.T) (*Client, *httptest.Server) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	c, err := New(BaseURL(srv.URL + "/api/v1/"))
+	if err != nil {
+		srv.Close()
+		t.Fatal(err)
+	}
+	return c, srv
+}
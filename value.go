@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	// Value is amount of lovelace and native assets.
+	// Value methods never modify receiver and assets with
+	// zero quantity are removed from the result.
+	Value struct {
+		Lovelace decimal.Decimal `json:"lovelace"`
+		// Assets quantities by policy id and asset name.
+		Assets map[PolicyID]map[AssetName]decimal.Decimal `json:"assets,omitempty"`
+	}
+)
+
+// NewValue returns Value of lovelace and assets.
+func NewValue(lovelace decimal.Decimal, assets ...Asset) Value {
+	v := Value{Lovelace: lovelace}
+	for _, a := range assets {
+		v.addAsset(a.PolicyID, a.AssetName, a.Quantity)
+	}
+	return v
+}
+
+// FormatADA formats lovelace amount as ada with 6 decimal places.
+func FormatADA(lovelace decimal.Decimal) string {
+	return lovelace.Shift(-6).StringFixed(6) + " ADA"
+}
+
+// ADA returns lovelace amount of value in ada.
+func (v Value) ADA() decimal.Decimal {
+	return v.Lovelace.Shift(-6)
+}
+
+// Quantity returns quantity of given asset.
+func (v Value) Quantity(policy PolicyID, name AssetName) decimal.Decimal {
+	return v.Assets[PolicyID(strings.ToLower(policy.String()))][AssetName(strings.ToLower(name.String()))]
+}
+
+// Add returns sum of v and o.
+func (v Value) Add(o Value) Value {
+	res := v.clone()
+	res.Lovelace = v.Lovelace.Add(o.Lovelace)
+	o.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		res.addAsset(policy, name, q)
+	})
+	return res
+}
+
+// Sub returns v minus o. Result may contain negative quantities.
+func (v Value) Sub(o Value) Value {
+	res := v.clone()
+	res.Lovelace = v.Lovelace.Sub(o.Lovelace)
+	o.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		res.addAsset(policy, name, q.Neg())
+	})
+	return res
+}
+
+// Cmp compares v and o. Values are partially ordered, so result is
+// -1 when every quantity of v is less or equal to o and at least one is less,
+// 0 when values are equal and +1 when every quantity of v is greater or equal.
+// It returns false when values are not comparable e.g. v has more ada
+// but less of some asset than o.
+func (v Value) Cmp(o Value) (int, bool) {
+	var less, greater bool
+	d := v.Sub(o)
+	check := func(q decimal.Decimal) {
+		switch q.Sign() {
+		case -1:
+			less = true
+		case 1:
+			greater = true
+		}
+	}
+	check(d.Lovelace)
+	d.each(func(_ PolicyID, _ AssetName, q decimal.Decimal) {
+		check(q)
+	})
+	switch {
+	case less && greater:
+		return 0, false
+	case less:
+		return -1, true
+	case greater:
+		return 1, true
+	}
+	return 0, true
+}
+
+// IsZero reports whether value has no lovelace and no assets.
+func (v Value) IsZero() bool {
+	if !v.Lovelace.IsZero() {
+		return false
+	}
+	zero := true
+	v.each(func(_ PolicyID, _ AssetName, q decimal.Decimal) {
+		zero = zero && q.IsZero()
+	})
+	return zero
+}
+
+// IsNegative reports whether any quantity of value is negative.
+func (v Value) IsNegative() bool {
+	neg := v.Lovelace.IsNegative()
+	v.each(func(_ PolicyID, _ AssetName, q decimal.Decimal) {
+		neg = neg || q.IsNegative()
+	})
+	return neg
+}
+
+// Filter returns value with lovelace and assets for which keep returns true.
+func (v Value) Filter(keep func(policy PolicyID, name AssetName, quantity decimal.Decimal) bool) Value {
+	res := Value{Lovelace: v.Lovelace}
+	v.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		if keep(policy, name, q) {
+			res.addAsset(policy, name, q)
+		}
+	})
+	return res
+}
+
+// AssetList returns assets of value sorted by policy id and asset name.
+func (v Value) AssetList() []Asset {
+	var list []Asset
+	v.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		list = append(list, Asset{PolicyID: policy, AssetName: name, Quantity: q})
+	})
+	return list
+}
+
+// String returns value formatted as ada amount followed by assets.
+func (v Value) String() string {
+	var sb strings.Builder
+	sb.WriteString(FormatADA(v.Lovelace))
+	v.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		sb.WriteString(" + ")
+		sb.WriteString(q.String())
+		sb.WriteByte(' ')
+		sb.WriteString(policy.String())
+		if len(name) > 0 {
+			sb.WriteByte('.')
+			sb.WriteString(name.String())
+		}
+	})
+	return sb.String()
+}
+
+// each calls fn for every asset sorted by policy id and asset name.
+func (v Value) each(fn func(policy PolicyID, name AssetName, quantity decimal.Decimal)) {
+	policies := make([]PolicyID, 0, len(v.Assets))
+	for policy := range v.Assets {
+		policies = append(policies, policy)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i] < policies[j] })
+	for _, policy := range policies {
+		names := make([]AssetName, 0, len(v.Assets[policy]))
+		for name := range v.Assets[policy] {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+		for _, name := range names {
+			fn(policy, name, v.Assets[policy][name])
+		}
+	}
+}
+
+func (v Value) clone() Value {
+	res := Value{Lovelace: v.Lovelace}
+	v.each(func(policy PolicyID, name AssetName, q decimal.Decimal) {
+		res.addAsset(policy, name, q)
+	})
+	return res
+}
+
+func (v *Value) addAsset(policy PolicyID, name AssetName, q decimal.Decimal) {
+	policy = PolicyID(strings.ToLower(policy.String()))
+	name = AssetName(strings.ToLower(name.String()))
+	sum := v.Assets[policy][name].Add(q)
+	if sum.IsZero() {
+		if names, ok := v.Assets[policy]; ok {
+			delete(names, name)
+			if len(names) == 0 {
+				delete(v.Assets, policy)
+			}
+		}
+		return
+	}
+	if v.Assets == nil {
+		v.Assets = make(map[PolicyID]map[AssetName]decimal.Decimal)
+	}
+	if v.Assets[policy] == nil {
+		v.Assets[policy] = make(map[AssetName]decimal.Decimal)
+	}
+	v.Assets[policy][name] = sum
+}
+
+// Amount returns lovelace and assets of the UTxO.
+func (u *UTxO) Amount() Value {
+	return NewValue(u.Value, u.AssetList...)
+}
+
+// SumUTxOs returns total value of UTxOs.
+func SumUTxOs(utxos []UTxO) Value {
+	v := Value{Lovelace: decimal.Zero}
+	for _, u := range utxos {
+		v.Lovelace = v.Lovelace.Add(u.Value)
+		for _, a := range u.AssetList {
+			v.addAsset(a.PolicyID, a.AssetName, a.Quantity)
+		}
+	}
+	return v
+}
+
+// Amount returns balance of the address including assets of its UTxO set.
+func (a *AddressInfo) Amount() Value {
+	v := SumUTxOs(a.UTxOs)
+	v.Lovelace = a.Balance
+	return v
+}
+
+// SumAccountAssets returns value of account assets.
+func SumAccountAssets(assets []AccountAssets) Value {
+	var v Value
+	for _, a := range assets {
+		v.addAsset(a.PolicyID, a.AssetName, a.Quantity)
+	}
+	return v
+}
+
+// InputsAmount returns total value of transaction inputs.
+func (tx *EUTxO) InputsAmount() Value {
+	return SumUTxOs(tx.Inputs)
+}
+
+// OutputsAmount returns total value of transaction outputs.
+func (tx *EUTxO) OutputsAmount() Value {
+	return SumUTxOs(tx.Outputs)
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright © 2022 The Cardano Community Authors
+
+package koios
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	valuePolicyA = PolicyID("1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209")
+	valuePolicyB = PolicyID("7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373")
+	valueName    = AssetName("504154415445")
+)
+
+func testValue(lovelace int64, assets ...Asset) Value {
+	return NewValue(decimal.NewFromInt(lovelace), assets...)
+}
+
+func testAsset(policy PolicyID, name AssetName, q int64) Asset {
+	return Asset{PolicyID: policy, AssetName: name, Quantity: decimal.NewFromInt(q)}
+}
+
+func TestValueAddSub(t *testing.T) {
+	upper := PolicyID(strings.ToUpper(valuePolicyA.String()))
+	tests := []struct {
+		name string
+		got  Value
+		want string
+	}{
+		{
+			name: "add",
+			got:  testValue(1000000, testAsset(valuePolicyA, valueName, 1)).Add(testValue(2, testAsset(valuePolicyB, "", 5))),
+			want: "1.000002 ADA + 1 " + valuePolicyA.String() + ".504154415445 + 5 " + valuePolicyB.String(),
+		},
+		{
+			name: "mixed case merged",
+			got: testValue(0, testAsset(valuePolicyA, valueName, 1)).
+				Add(testValue(0, testAsset(upper, AssetName(strings.ToUpper(valueName.String())), 2))),
+			want: "0.000000 ADA + 3 " + valuePolicyA.String() + ".504154415445",
+		},
+		{
+			name: "sub negative",
+			got:  testValue(1, testAsset(valuePolicyA, valueName, 1)).Sub(testValue(3, testAsset(valuePolicyA, valueName, 4))),
+			want: "-0.000002 ADA + -3 " + valuePolicyA.String() + ".504154415445",
+		},
+		{
+			name: "sub pruned",
+			got:  testValue(5, testAsset(valuePolicyA, valueName, 4)).Sub(testValue(0, testAsset(upper, valueName, 4))),
+			want: "0.000005 ADA",
+		},
+		{
+			name: "zero quantity pruned",
+			got:  testValue(0, testAsset(valuePolicyA, valueName, 0), testAsset(valuePolicyB, "", 1), testAsset(valuePolicyB, "", -1)),
+			want: "0.000000 ADA",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if s := tt.got.String(); s != tt.want {
+				t.Errorf("expected %s got %s", tt.want, s)
+			}
+			for _, names := range tt.got.Assets {
+				if len(names) == 0 {
+					t.Error("empty policy is not pruned")
+				}
+				for name, q := range names {
+					if q.IsZero() {
+						t.Errorf("zero quantity of %s is not pruned", name)
+					}
+				}
+			}
+		})
+	}
+
+	v := testValue(1, testAsset(valuePolicyA, valueName, 1))
+	_ = v.Add(testValue(1, testAsset(valuePolicyA, valueName, 1)))
+	if q := v.Quantity(valuePolicyA, valueName); !q.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("receiver modified, quantity %s", q)
+	}
+	if q := v.Quantity(upper, valueName); !q.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("expected quantity 1 for mixed case policy got %s", q)
+	}
+}
+
+func TestValueCmp(t *testing.T) {
+	tests := []struct {
+		name string
+		v, o Value
+		cmp  int
+		ok   bool
+	}{
+		{name: "equal", v: testValue(1, testAsset(valuePolicyA, valueName, 1)), o: testValue(1, testAsset(valuePolicyA, valueName, 1)), cmp: 0, ok: true},
+		{name: "less lovelace", v: testValue(1), o: testValue(2), cmp: -1, ok: true},
+		{name: "greater asset", v: testValue(1, testAsset(valuePolicyA, valueName, 2)), o: testValue(1, testAsset(valuePolicyA, valueName, 1)), cmp: 1, ok: true},
+		{name: "less missing asset", v: testValue(1), o: testValue(1, testAsset(valuePolicyA, valueName, 1)), cmp: -1, ok: true},
+		{name: "disjoint assets", v: testValue(1, testAsset(valuePolicyA, valueName, 1)), o: testValue(1, testAsset(valuePolicyB, valueName, 1)), ok: false},
+		{name: "more ada less asset", v: testValue(2), o: testValue(1, testAsset(valuePolicyA, valueName, 1)), ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmp, ok := tt.v.Cmp(tt.o)
+			if ok != tt.ok || (ok && cmp != tt.cmp) {
+				t.Errorf("expected %d, %t got %d, %t", tt.cmp, tt.ok, cmp, ok)
+			}
+		})
+	}
+}
+
+func TestValuePredicates(t *testing.T) {
+	if !(Value{}).IsZero() || !testValue(0).IsZero() {
+		t.Error("expected empty value to be zero")
+	}
+	if testValue(0, testAsset(valuePolicyA, valueName, 1)).IsZero() {
+		t.Error("expected value with asset not to be zero")
+	}
+	if !testValue(1).Sub(testValue(2)).IsNegative() {
+		t.Error("expected negative lovelace")
+	}
+	if !testValue(1).Sub(testValue(0, testAsset(valuePolicyA, valueName, 1))).IsNegative() {
+		t.Error("expected negative asset")
+	}
+
+	v := testValue(7, testAsset(valuePolicyA, valueName, 1), testAsset(valuePolicyB, "", 2))
+	f := v.Filter(func(policy PolicyID, _ AssetName, _ decimal.Decimal) bool {
+		return policy == valuePolicyB
+	})
+	if got, want := f.String(), "0.000007 ADA + 2 "+valuePolicyB.String(); got != want {
+		t.Errorf("expected %s got %s", want, got)
+	}
+	if list := v.AssetList(); len(list) != 2 || list[0].PolicyID != valuePolicyA {
+		t.Errorf("unexpected asset list %v", list)
+	}
+}
+
+func TestSumValues(t *testing.T) {
+	utxos := []UTxO{
+		{Value: decimal.NewFromInt(2000000), AssetList: []Asset{testAsset(valuePolicyA, valueName, 10)}},
+		{Value: decimal.NewFromInt(1000000), AssetList: []Asset{testAsset(PolicyID(strings.ToUpper(valuePolicyA.String())), valueName, 5)}},
+		{Value: decimal.NewFromInt(500000)},
+	}
+	if got, want := SumUTxOs(utxos).String(), "3.500000 ADA + 15 "+valuePolicyA.String()+".504154415445"; got != want {
+		t.Errorf("SumUTxOs: expected %s got %s", want, got)
+	}
+	if got := SumUTxOs(nil); !got.IsZero() || got.String() != "0.000000 ADA" {
+		t.Errorf("SumUTxOs: expected zero value got %s", got)
+	}
+
+	assets := []AccountAssets{
+		{Asset: testAsset(valuePolicyA, valueName, 3)},
+		{Asset: testAsset(valuePolicyA, valueName, -3)},
+		{Asset: testAsset(valuePolicyB, "", 1)},
+	}
+	if got, want := SumAccountAssets(assets).String(), "0.000000 ADA + 1 "+valuePolicyB.String(); got != want {
+		t.Errorf("SumAccountAssets: expected %s got %s", want, got)
+	}
+}